@@ -1,6 +1,24 @@
 import { mkdir, readFile, writeFile } from 'node:fs/promises';
 import path from 'node:path';
+import { createElement } from 'react';
+import { renderToStaticMarkup } from 'react-dom/server';
+import Markdown from 'react-markdown';
+import remarkGfm from 'remark-gfm';
 import { SimplePool } from 'nostr-tools/pool';
+import { encodeArticleNaddr } from '../src/lib/articleAddress.js';
+import {
+  buildAtomXml,
+  buildJsonFeed,
+  buildRssXml,
+  escapeHtml,
+  getArchivePeriods,
+  getAuthorPages,
+  getFeedArticles,
+  getLatestArticles,
+  getTagValue,
+  toManifestIcon,
+  toShortName,
+} from '../src/lib/staticSite.js';
 
 const distDir = path.resolve(process.cwd(), 'dist');
 const indexPath = path.join(distDir, 'index.html');
@@ -12,13 +30,33 @@ const siteUrl = (process.env.VITE_SITE_URL || '').replace(/\/$/, '');
 const envOgImage = process.env.VITE_OG_IMAGE || '';
 const relayUrl = (process.env.VITE_DEFAULT_RELAY || '').replace(/\/$/, '');
 const masterPubkey = (process.env.VITE_MASTER_PUBKEY || '').trim().toLowerCase();
+const nostrJsonUrl = process.env.VITE_REMOTE_NOSTR_JSON_URL || (siteUrl ? `${siteUrl}/.well-known/nostr.json` : '');
 
 const DEFAULT_SITE_TITLE = 'Community Meetup Site';
 const DEFAULT_HOME_DESCRIPTION = 'Join us for amazing meetups and events';
 const DEFAULT_BLOG_DESCRIPTION = 'Read our latest blog posts and community updates.';
 const DEFAULT_EVENTS_DESCRIPTION = 'Browse upcoming and past community events and meetups.';
 const DEFAULT_EVENT_DESCRIPTION = 'Event details and RSVP information';
+const MONTH_NAMES = ['January', 'February', 'March', 'April', 'May', 'June', 'July', 'August', 'September', 'October', 'November', 'December'];
 const LEGACY_SITE_CONFIG_DTAG = 'nostr-meetup-site-config';
+const FEED_ITEM_LIMIT = 50;
+// Longest file or directory name most filesystems accept
+const MAX_PATH_SEGMENT_BYTES = 255;
+const SENSITIVE_NOTICE = 'This post contains sensitive content.';
+
+// User agents of crawlers that collect training data for AI models
+const AI_CRAWLER_USER_AGENTS = [
+  'GPTBot',
+  'ChatGPT-User',
+  'ClaudeBot',
+  'anthropic-ai',
+  'Google-Extended',
+  'CCBot',
+  'PerplexityBot',
+  'Bytespider',
+  'Applebot-Extended',
+  'meta-externalagent',
+];
 
 function getScopedSiteConfigDTag(relay) {
   return `nostr-meetup-site-config:${relay.replace(/\/$/, '')}`;
@@ -28,10 +66,6 @@ function pickLatestEvent(events) {
   return [...events].sort((a, b) => b.created_at - a.created_at)[0] || null;
 }
 
-function getTagValue(tags, name) {
-  return tags.find(([tagName]) => tagName === name)?.[1] || '';
-}
-
 function parseAdminRoles(adminRolesTag) {
   if (!adminRolesTag) return {};
 
@@ -47,6 +81,7 @@ function parseAdminRoles(adminRolesTag) {
   return {};
 }
 
+
 function canUseAuthor(pubkey, adminRoles) {
   const author = (pubkey || '').toLowerCase().trim();
   if (!author) return false;
@@ -97,13 +132,23 @@ async function fetchSiteConfigFromRelay(pool) {
     const title = getTagValue(tags, 'title');
     const heroSubtitle = getTagValue(tags, 'hero_subtitle');
     const ogImage = getTagValue(tags, 'og_image');
+    const favicon = getTagValue(tags, 'favicon');
+    const logo = getTagValue(tags, 'logo');
     const adminRoles = parseAdminRoles(getTagValue(tags, 'admin_roles'));
+    const blockAiCrawlers = getTagValue(tags, 'block_ai_crawlers') === 'true';
+    const defaultLicense = getTagValue(tags, 'default_license');
+    const feedTitle = getTagValue(tags, 'feed_title');
 
     return {
       title,
       heroSubtitle,
       ogImage,
+      favicon,
+      logo,
       adminRoles,
+      blockAiCrawlers,
+      defaultLicense,
+      feedTitle,
     };
   } catch (error) {
     console.warn('[seo] failed to fetch kind 30078 site-config, using defaults:', error);
@@ -151,20 +196,10 @@ async function fetchContentForDynamicRoutes(pool, siteConfig) {
     ),
   ]);
 
-  const blogPosts = postEvents
-    .filter((event) => canUseAuthor(event.pubkey, adminRoles))
-    .filter((event) => getTagValue(event.tags || [], 'published') !== 'false')
-    .map((event) => {
-      const tags = event.tags || [];
-      return {
-        id: event.id,
-        title: getTagValue(tags, 'title') || 'Untitled',
-        content: event.content || '',
-        image: getTagValue(tags, 'image'),
-        createdAt: event.created_at,
-      };
-    })
-    .sort((a, b) => b.createdAt - a.createdAt);
+  const blogPosts = getLatestArticles(
+    postEvents.filter((event) => canUseAuthor(event.pubkey, adminRoles)),
+    siteConfig?.defaultLicense || '',
+  );
 
   const events = calendarEvents
     .filter((event) => canUseAuthor(event.pubkey, adminRoles))
@@ -183,20 +218,11 @@ async function fetchContentForDynamicRoutes(pool, siteConfig) {
   return { blogPosts, events };
 }
 
-function escapeHtml(value) {
-  return value
-    .replace(/&/g, '&amp;')
-    .replace(/</g, '&lt;')
-    .replace(/>/g, '&gt;')
-    .replace(/"/g, '&quot;')
-    .replace(/'/g, '&#39;');
-}
-
-function buildRoutes(siteConfig, contentData) {
+function buildRoutes(siteConfig, contentData, teamNames) {
   const siteTitle = siteConfig?.title || DEFAULT_SITE_TITLE;
   const homeDescription = siteConfig?.heroSubtitle || DEFAULT_HOME_DESCRIPTION;
   const globalPreviewImage = envOgImage || siteConfig?.ogImage || '';
-  const blogPreviewImage = envOgImage || contentData.blogPosts.find((post) => post.image)?.image || siteConfig?.ogImage || '';
+  const blogPreviewImage = envOgImage || contentData.blogPosts.find((post) => post.image && !post.sensitive)?.image || siteConfig?.ogImage || '';
   const eventsPreviewImage = envOgImage || contentData.events.find((event) => event.image)?.image || siteConfig?.ogImage || '';
 
   const routes = [
@@ -221,11 +247,43 @@ function buildRoutes(siteConfig, contentData) {
   ];
 
   for (const post of contentData.blogPosts) {
-    routes.push({
-      path: `/blog/${post.id}`,
+    // Flagged posts keep their title but never leak body text or images into link previews
+    const articleMeta = {
       title: `${post.title} - ${siteTitle}`,
-      description: summarizeText(post.content, DEFAULT_BLOG_DESCRIPTION),
-      previewImage: post.image || blogPreviewImage || globalPreviewImage,
+      description: post.sensitive ? SENSITIVE_NOTICE : summarizeText(post.summary || post.content, DEFAULT_BLOG_DESCRIPTION),
+      previewImage: post.sensitive ? globalPreviewImage : post.image || blogPreviewImage || globalPreviewImage,
+      type: 'article',
+      publishedTime: new Date(post.createdAt * 1000).toISOString(),
+      canonicalPath: `/blog/${post.id}`,
+    };
+    routes.push({ path: `/blog/${post.id}`, ...articleMeta });
+  }
+
+  // Meta for the naddr this site hands out (Copy permalink, reader pages).
+  // A client that encodes a different relay hint produces different bytes,
+  // gets the generic app shell, and the app then forwards it to the article.
+  for (const { post, naddr } of getNaddrArticles(contentData.blogPosts).included) {
+    const blogRoute = routes.find((route) => route.path === `/blog/${post.id}`);
+    routes.push({ ...blogRoute, path: `/${naddr}` });
+  }
+
+  for (const period of getArchivePeriods(contentData.blogPosts)) {
+    const label = `${MONTH_NAMES[period.month - 1]} ${period.year}`;
+    routes.push({
+      path: `/archive/${period.year}/${String(period.month).padStart(2, '0')}`,
+      title: `Archive: ${label} - ${siteTitle}`,
+      description: `${period.count} ${period.count === 1 ? 'post' : 'posts'} published in ${label}.`,
+      previewImage: globalPreviewImage,
+    });
+  }
+
+  for (const author of getAuthorPages(contentData.blogPosts, teamNames)) {
+    routes.push({
+      path: `/author/${author.name}`,
+      title: `${author.name} - ${siteTitle}`,
+      description: `${author.count} ${author.count === 1 ? 'post' : 'posts'} by ${author.name}.`,
+      previewImage: globalPreviewImage,
+      type: 'profile',
     });
   }
 
@@ -252,7 +310,7 @@ function toAbsoluteUrl(value) {
 function buildSeoMetaBlock(route) {
   const title = escapeHtml(route.title);
   const description = escapeHtml(route.description);
-  const ogUrl = toAbsoluteUrl(route.path);
+  const ogUrl = toAbsoluteUrl(route.canonicalPath || route.path);
   const ogImage = toAbsoluteUrl(route.previewImage || '');
 
   const lines = [
@@ -260,7 +318,7 @@ function buildSeoMetaBlock(route) {
     `    <meta name="description" content="${description}" />`,
     `    <meta property="og:title" content="${title}" />`,
     `    <meta property="og:description" content="${description}" />`,
-    '    <meta property="og:type" content="website" />',
+    `    <meta property="og:type" content="${route.type || 'website'}" />`,
     '    <meta name="twitter:card" content="summary_large_image" />',
     `    <meta name="twitter:title" content="${title}" />`,
     `    <meta name="twitter:description" content="${description}" />`,
@@ -270,14 +328,267 @@ function buildSeoMetaBlock(route) {
     lines.push(`    <meta property="og:url" content="${escapeHtml(ogUrl)}" />`);
   }
 
+  if (route.publishedTime) {
+    lines.push(`    <meta property="article:published_time" content="${route.publishedTime}" />`);
+  }
+
+  if (route.canonicalPath && siteUrl) {
+    lines.push(`    <link rel="canonical" href="${escapeHtml(toAbsoluteUrl(route.canonicalPath))}" />`);
+  }
+
   if (ogImage) {
     lines.push(`    <meta property="og:image" content="${escapeHtml(ogImage)}" />`);
     lines.push(`    <meta name="twitter:image" content="${escapeHtml(ogImage)}" />`);
   }
 
+  // Feeds are only generated when absolute links are available
+  if (siteUrl) {
+    lines.push(`    <link rel="alternate" type="application/rss+xml" title="RSS" href="${escapeHtml(toAbsoluteUrl('/feed.xml'))}" />`);
+  }
+
   return lines.join('\n');
 }
 
+// Same encoding as the app's "Copy permalink" (src/lib/permalink.ts)
+function getArticleNaddr(post) {
+  return encodeArticleNaddr(post.pubkey, post.d, relayUrl);
+}
+
+function renderMarkdown(content) {
+  // react-markdown escapes raw HTML and unsafe URLs, so relay content is safe to inline
+  return renderToStaticMarkup(createElement(Markdown, { remarkPlugins: [remarkGfm] }, content));
+}
+
+// Script-free article view for slow connections and read-later services.
+function buildReaderHtml(post, siteTitle) {
+  const title = escapeHtml(post.title);
+  const articlePath = `/blog/${post.id}`;
+  const canonicalUrl = toAbsoluteUrl(articlePath);
+  const published = new Date(post.createdAt * 1000).toISOString().slice(0, 10);
+  // Same click-through as the app's blur overlay, without JavaScript
+  const body = post.sensitive
+    ? `<details>
+        <summary>Sensitive content${post.contentWarning ? `: ${escapeHtml(post.contentWarning)}` : ''}. Show</summary>
+        ${renderMarkdown(post.content)}
+      </details>`
+    : renderMarkdown(post.content);
+  const license = post.license
+    ? `<p class="meta">Licensed under ${escapeHtml(post.license)}</p>`
+    : '';
+
+  return `<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8" />
+    <meta name="viewport" content="width=device-width, initial-scale=1.0" />
+    <title>${title} - ${escapeHtml(siteTitle)}</title>
+    <meta name="description" content="${escapeHtml(post.sensitive ? SENSITIVE_NOTICE : summarizeText(post.summary || post.content, DEFAULT_BLOG_DESCRIPTION))}" />
+    <link rel="canonical" href="${escapeHtml(canonicalUrl)}" />
+    <style>
+      body { max-width: 42rem; margin: 2rem auto; padding: 0 1rem; font: 18px/1.6 Georgia, serif; color: #222; background: #fff; }
+      h1 { line-height: 1.2; }
+      img, video { max-width: 100%; height: auto; }
+      pre { overflow-x: auto; padding: 0.75rem; background: #f4f4f4; }
+      .meta { color: #666; font-size: 0.9rem; }
+      @media (prefers-color-scheme: dark) { body { color: #ddd; background: #111; } pre { background: #222; } a { color: #8ab4f8; } }
+    </style>
+  </head>
+  <body>
+    <p class="meta"><a href="/">${escapeHtml(siteTitle)}</a></p>
+    <article>
+      <h1>${title}</h1>
+      <p class="meta"><time datetime="${published}">${published}</time> · <a href="${articlePath}">View on the site</a></p>
+      ${body}
+    </article>
+    ${license}
+  </body>
+</html>
+`;
+}
+
+// Static counts per period so clients can render archive navigation without
+// scanning every article.
+async function writeArchiveIndex(contentData) {
+  const periods = getArchivePeriods(contentData.blogPosts).map((period) => ({
+    ...period,
+    path: `/archive/${period.year}/${String(period.month).padStart(2, '0')}`,
+  }));
+
+  await writeFile(path.join(distDir, 'archive.json'), `${JSON.stringify({ periods }, null, 2)}\n`, 'utf8');
+  console.log(`[seo] generated archive.json with ${periods.length} periods`);
+}
+
+// Latest articles whose naddr can be used as a directory name. Long d-tags
+// (some clients use full-title slugs) produce naddrs past the filesystem
+// limit; those articles keep only their /blog/<id> page.
+function getNaddrArticles(blogPosts) {
+  const included = [];
+  const skipped = [];
+
+  for (const post of blogPosts) {
+    if (!post.d) continue;
+    const naddr = getArticleNaddr(post);
+    if (Buffer.byteLength(naddr) > MAX_PATH_SEGMENT_BYTES) {
+      skipped.push(post);
+    } else {
+      included.push({ post, naddr });
+    }
+  }
+
+  return { included, skipped };
+}
+
+async function writeReaderPages(siteConfig, contentData) {
+  const siteTitle = siteConfig?.title || DEFAULT_SITE_TITLE;
+  const { included, skipped } = getNaddrArticles(contentData.blogPosts);
+
+  for (const { post, naddr } of included) {
+    const outputPath = path.join(distDir, 'r', naddr, 'index.html');
+    await mkdir(path.dirname(outputPath), { recursive: true });
+    await writeFile(outputPath, buildReaderHtml(post, siteTitle), 'utf8');
+  }
+
+  for (const post of skipped) {
+    console.warn(`[seo] skipping naddr pages for "${post.title}" (${post.id}): naddr too long for a path segment`);
+  }
+
+  console.log(`[seo] generated ${included.length} reader pages`);
+}
+
+// Canonical public URLs for search engines. Articles are listed under
+// `/blog/<id>`, which their naddr and reader pages point to as canonical.
+async function writeSitemap(contentData, teamNames) {
+  if (!siteUrl) {
+    console.log('[seo] skipping sitemap.xml (missing VITE_SITE_URL)');
+    return;
+  }
+
+  const toDate = (timestamp) => new Date(timestamp * 1000).toISOString().slice(0, 10);
+  const articles = contentData.blogPosts;
+
+  const entries = [
+    { path: '/', lastmod: articles[0]?.createdAt },
+    { path: '/blog', lastmod: articles[0]?.createdAt },
+    { path: '/events', lastmod: contentData.events[0]?.createdAt },
+    ...articles.map((post) => ({ path: `/blog/${post.id}`, lastmod: post.createdAt })),
+    ...contentData.events.map((event) => ({ path: `/event/${event.id}`, lastmod: event.createdAt })),
+    // Author hubs only for team members who have published something
+    ...getAuthorPages(articles, teamNames).map((author) => ({ path: `/author/${author.name}`, lastmod: author.lastmod })),
+    ...getArchivePeriods(contentData.blogPosts).map((period) => ({
+      path: `/archive/${period.year}/${String(period.month).padStart(2, '0')}`,
+    })),
+  ];
+
+  const urls = entries.map((entry) => [
+    '  <url>',
+    `    <loc>${escapeHtml(toAbsoluteUrl(entry.path))}</loc>`,
+    entry.lastmod ? `    <lastmod>${toDate(entry.lastmod)}</lastmod>` : '',
+    '  </url>',
+  ].filter(Boolean).join('\n'));
+
+  const xml = `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+${urls.join('\n')}
+</urlset>
+`;
+
+  await writeFile(path.join(distDir, 'sitemap.xml'), xml, 'utf8');
+  console.log(`[seo] generated sitemap.xml with ${entries.length} URLs`);
+}
+
+// Team names from nostr.json, used for author pages, per-author feed paths and
+// item authors.
+async function fetchTeamNames() {
+  if (!/^https?:\/\//i.test(nostrJsonUrl)) return {};
+
+  try {
+    const response = await fetch(nostrJsonUrl, { signal: AbortSignal.timeout(5000) });
+    if (!response.ok) throw new Error(`HTTP ${response.status}`);
+    const { names } = await response.json();
+    if (!names || typeof names !== 'object') return {};
+
+    const result = {};
+    for (const [name, pubkey] of Object.entries(names)) {
+      // `_` is the domain's root identity, not an author name
+      // Names become path segments, so `.` and `..` are rejected too
+      if (name === '_' || !/^[a-z0-9._-]+$/i.test(name) || /^\.+$/.test(name) || typeof pubkey !== 'string') continue;
+      result[name] = pubkey.toLowerCase().trim();
+    }
+    return result;
+  } catch (error) {
+    console.warn('[seo] failed to fetch nostr.json, skipping per-author feeds:', error);
+    return {};
+  }
+}
+
+// Item ids must survive edits, and the event id changes with every revision.
+// An naddr without a relay hint depends only on the article's coordinate
+// and still opens the article on the site.
+function getFeedItemId(post) {
+  return toAbsoluteUrl(post.d ? `/${encodeArticleNaddr(post.pubkey, post.d)}` : `/blog/${post.id}`);
+}
+
+function toFeedItem(post, authorNames) {
+  const url = toAbsoluteUrl(`/blog/${post.id}`);
+  return {
+    id: getFeedItemId(post),
+    url,
+    title: post.title,
+    author: authorNames.get(post.pubkey) || '',
+    summary: summarizeText(post.summary || post.content, ''),
+    html: renderMarkdown(post.content),
+    license: post.license,
+    date: new Date(post.createdAt * 1000),
+  };
+}
+
+// RSS, Atom and JSON feeds of published articles, plus an RSS feed per team
+// member listed in nostr.json.
+async function writeFeeds(siteConfig, contentData, teamNames) {
+  if (!siteUrl) {
+    console.log('[seo] skipping feeds (missing VITE_SITE_URL)');
+    return;
+  }
+
+  const feedTitle = siteConfig?.feedTitle || siteConfig?.title || DEFAULT_SITE_TITLE;
+  const authorNames = new Map(Object.entries(teamNames).map(([name, pubkey]) => [pubkey, name]));
+  const articles = getFeedArticles(contentData.blogPosts);
+  const baseFeed = {
+    title: feedTitle,
+    description: DEFAULT_BLOG_DESCRIPTION,
+    homeUrl: toAbsoluteUrl('/blog'),
+    license: siteConfig?.defaultLicense || '',
+  };
+
+  const items = articles.slice(0, FEED_ITEM_LIMIT).map((post) => toFeedItem(post, authorNames));
+  await writeFile(path.join(distDir, 'feed.xml'), buildRssXml({ ...baseFeed, feedUrl: toAbsoluteUrl('/feed.xml'), items }), 'utf8');
+  await writeFile(path.join(distDir, 'atom.xml'), buildAtomXml({ ...baseFeed, feedUrl: toAbsoluteUrl('/atom.xml'), items }), 'utf8');
+  await writeFile(path.join(distDir, 'feed.json'), buildJsonFeed({ ...baseFeed, feedUrl: toAbsoluteUrl('/feed.json'), items }), 'utf8');
+  console.log(`[seo] generated feed.xml, atom.xml and feed.json with ${items.length} items`);
+
+  let authorFeeds = 0;
+  for (const [name, pubkey] of Object.entries(teamNames)) {
+    const authorItems = articles
+      .filter((post) => post.pubkey === pubkey)
+      .slice(0, FEED_ITEM_LIMIT)
+      .map((post) => toFeedItem(post, authorNames));
+    if (authorItems.length === 0) continue;
+
+    const feedPath = `/feed/${name}.xml`;
+    await mkdir(path.join(distDir, 'feed'), { recursive: true });
+    await writeFile(
+      path.join(distDir, 'feed', `${name}.xml`),
+      buildRssXml({ ...baseFeed, title: `${feedTitle} - ${name}`, feedUrl: toAbsoluteUrl(feedPath), items: authorItems }),
+      'utf8',
+    );
+    authorFeeds += 1;
+  }
+
+  if (authorFeeds > 0) {
+    console.log(`[seo] generated ${authorFeeds} per-author feeds`);
+  }
+}
+
 function outputPathForRoute(routePath) {
   if (routePath === '/') {
     return path.join(distDir, 'index.html');
@@ -286,16 +597,69 @@ function outputPathForRoute(routePath) {
   return path.join(distDir, routePath.replace(/^\//, ''), 'index.html');
 }
 
+// Brands the web manifest copied from public/ with the relay's site config,
+// so each deployment installs under its own name and icon.
+async function writeManifest(siteConfig) {
+  const manifestPath = path.join(distDir, 'manifest.webmanifest');
+  const manifest = JSON.parse(await readFile(manifestPath, 'utf8'));
+
+  if (siteConfig?.title) {
+    manifest.name = siteConfig.title;
+    manifest.short_name = toShortName(siteConfig.title);
+  }
+  if (siteConfig?.heroSubtitle) {
+    manifest.description = siteConfig.heroSubtitle;
+  }
+
+  const brandedIcons = [siteConfig?.logo, siteConfig?.favicon]
+    .filter(Boolean)
+    .map(toManifestIcon);
+  if (brandedIcons.length > 0) {
+    manifest.icons = [...brandedIcons, ...(manifest.icons || [])];
+  }
+
+  await writeFile(manifestPath, `${JSON.stringify(manifest, null, 2)}\n`, 'utf8');
+  console.log('[seo] generated manifest.webmanifest');
+}
+
+// Keeps crawlers out of the dashboard and relay API; media stays crawlable so
+// link previews keep working.
+async function writeRobotsTxt(siteConfig) {
+  const lines = [
+    'User-agent: *',
+    'Allow: /',
+    'Disallow: /admin',
+    'Disallow: /api/',
+  ];
+
+  if (siteConfig?.blockAiCrawlers) {
+    for (const userAgent of AI_CRAWLER_USER_AGENTS) {
+      lines.push('', `User-agent: ${userAgent}`, 'Disallow: /');
+    }
+  }
+
+  if (siteUrl) {
+    lines.push('', `Sitemap: ${toAbsoluteUrl('/sitemap.xml')}`);
+  }
+
+  await writeFile(path.join(distDir, 'robots.txt'), `${lines.join('\n')}\n`, 'utf8');
+  console.log('[seo] generated robots.txt');
+}
+
 async function generateRouteMetaHtml() {
   const sourceHtml = await readFile(indexPath, 'utf8');
   const pool = new SimplePool({ enableReconnect: false });
 
   let siteConfig = null;
   let contentData = { blogPosts: [], events: [] };
+  let teamNames = {};
 
   try {
     siteConfig = await fetchSiteConfigFromRelay(pool);
-    contentData = await fetchContentForDynamicRoutes(pool, siteConfig);
+    [contentData, teamNames] = await Promise.all([
+      fetchContentForDynamicRoutes(pool, siteConfig),
+      fetchTeamNames(),
+    ]);
   } finally {
     if (relayUrl) {
       pool.close([relayUrl]);
@@ -303,7 +667,7 @@ async function generateRouteMetaHtml() {
     pool.destroy();
   }
 
-  const routes = buildRoutes(siteConfig, contentData);
+  const routes = buildRoutes(siteConfig, contentData, teamNames);
 
   if (!sourceHtml.includes(SEO_META_START) || !sourceHtml.includes(SEO_META_END)) {
     throw new Error('SEO markers not found in index.html.');
@@ -320,6 +684,13 @@ async function generateRouteMetaHtml() {
     await writeFile(outputPath, routeHtml, 'utf8');
     console.log(`[seo] generated ${path.relative(distDir, outputPath)}`);
   }
+
+  await writeManifest(siteConfig);
+  await writeRobotsTxt(siteConfig);
+  await writeReaderPages(siteConfig, contentData);
+  await writeArchiveIndex(contentData);
+  await writeSitemap(contentData, teamNames);
+  await writeFeeds(siteConfig, contentData, teamNames);
 }
 
 generateRouteMetaHtml().catch((error) => {
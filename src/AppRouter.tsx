@@ -1,8 +1,8 @@
-import { BrowserRouter, Route, Routes } from "react-router-dom";
+import { BrowserRouter, Route, Routes, useParams } from "react-router-dom";
 import { ScrollToTop } from "./components/ScrollToTop";
 
 import Index from "./pages/Index";
-import { NIP19Page } from "./pages/NIP19Page";
+import { NIP19Page, ReaderViewFallback, isNip19Path } from "./pages/NIP19Page";
 import NotFound from "./pages/NotFound";
 
 // Admin pages
@@ -17,6 +17,7 @@ import AdminPagesPage from "./pages/admin/AdminPagesPage";
 import AdminSettingsPage from "./pages/admin/AdminSettingsPage";
 import AdminSystemSettingsPage from "./pages/admin/AdminSystemSettingsPage";
 import AdminMediaPage from "./pages/admin/AdminMediaPage";
+import AdminEmojiPage from "./pages/admin/AdminEmojiPage";
 import AdminProfilePage from "./pages/admin/AdminProfilePage";
 import AdminLoginPage from "./pages/admin/AdminLoginPage";
 import AdminHelpPage from "./pages/admin/AdminHelpPage";
@@ -30,9 +31,11 @@ import EventsPage from "./pages/EventsPage";
 import EventPage from "./pages/EventPage";
 import BlogPage from "./pages/BlogPage";
 import BlogPostPage from "./pages/BlogPostPage";
+import ArchivePage from "./pages/ArchivePage";
 import FeedPage from "./pages/FeedPage";
 import StaticPage from "./pages/StaticPage";
 import ProfilePage from "./pages/ProfilePage";
+import AuthorPage from "./pages/AuthorPage";
 import FormPage from "./pages/FormPage";
 import FormOrStaticPage from "./pages/FormOrStaticPage";
 
@@ -40,6 +43,13 @@ function AdminRoutes() {
   return <AdminWrapper />;
 }
 
+// React Router 6 has no inline regex in paths, so NIP-19 identifiers share the
+// single-segment route with forms and static pages
+function RootPathRoute() {
+  const { path = '' } = useParams<{ path: string }>();
+  return isNip19Path(path) ? <NIP19Page identifier={path} /> : <FormOrStaticPage />;
+}
+
 export function AppRouter() {
   return (
     <BrowserRouter>
@@ -51,13 +61,13 @@ export function AppRouter() {
         <Route path="/event/:eventId" element={<EventPage />} />
         <Route path="/blog" element={<BlogPage />} />
         <Route path="/blog/:postId" element={<BlogPostPage />} />
+        <Route path="/r/:naddr" element={<ReaderViewFallback />} />
+        <Route path="/archive" element={<ArchivePage />} />
+        <Route path="/archive/:year" element={<ArchivePage />} />
+        <Route path="/archive/:year/:month" element={<ArchivePage />} />
         <Route path="/feed" element={<FeedPage />} />
         <Route path="/profile" element={<ProfilePage />} />
-        {/* NIP-19 route for npub1, note1, naddr1, nevent1, nprofile1 */}
-        <Route
-          path="/:nip19(npub1[a-z0-9]+|nprofile1[a-z0-9]+|note1[a-z0-9]+|nevent1[a-z0-9]+|naddr1[a-z0-9]+)"
-          element={<NIP19Page />}
-        />
+        <Route path="/author/:name" element={<AuthorPage />} />
         {/* Static pages (about, contact, etc.) */}
         <Route path="/about" element={<StaticPage pathOverride="/about" />} />
         <Route path="/contact" element={<StaticPage pathOverride="/contact" />} />
@@ -82,13 +92,15 @@ export function AppRouter() {
           <Route path="settings" element={<AdminSettingsPage />} />
           <Route path="system-settings" element={<AdminSystemSettingsPage />} />
           <Route path="media" element={<AdminMediaPage />} />
+          <Route path="emoji" element={<AdminEmojiPage />} />
           <Route path="profile" element={<AdminProfilePage />} />
           <Route path="help" element={<AdminHelpPage />} />
         </Route>
         <Route path="/admin/login" element={<AdminLoginPage />} />
 
-        {/* Dynamic Static Pages or LinkedForms (catch-all for routes not matched above) */}
-        <Route path="/:path" element={<FormOrStaticPage />} />
+        {/* NIP-19 identifiers (npub1, note1, naddr1, nevent1, nprofile1), then
+            Dynamic Static Pages or LinkedForms (catch-all for routes not matched above) */}
+        <Route path="/:path" element={<RootPathRoute />} />
 
         {/* ADD ALL CUSTOM ROUTES ABOVE THE CATCH-ALL "*" ROUTE */}
         <Route path="*" element={<NotFound />} />
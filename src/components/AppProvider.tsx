@@ -1,4 +1,4 @@
-import { ReactNode, useEffect, useCallback, useMemo } from 'react';
+import { ReactNode, useEffect, useCallback, useMemo, useState } from 'react';
 import { z } from 'zod';
 import { useHead, useSeoMeta } from '@unhead/react';
 import { useLocalStorage } from '@/hooks/useLocalStorage';
@@ -55,6 +55,10 @@ const AppConfigSchema = z.object({
     excludedBlossomRelays: z.array(z.string()).optional(),
     updatedAt: z.number().optional(),
     readOnlyAdminAccess: z.boolean().optional(),
+    blockAiCrawlers: z.boolean().optional(),
+    defaultLicense: z.string().optional(),
+    feedTitle: z.string().optional(),
+    staleAfterDays: z.number().optional(),
   }).optional(),
   navigation: z.array(z.object({
     id: z.string(),
@@ -97,6 +101,9 @@ export function AppProvider(props: AppProviderProps) {
     setConfig(updater);
   }, [setConfig]);
 
+  // Not persisted: cached admin roles may be stale until the relay has answered
+  const [siteConfigSynced, setSiteConfigSynced] = useState(false);
+
   const config = useMemo(() => {
     // Start with defaultConfig
     const merged = { ...defaultConfig };
@@ -144,7 +151,9 @@ export function AppProvider(props: AppProviderProps) {
   const appContextValue: AppContextType = useMemo(() => ({
     config,
     updateConfig,
-  }), [config, updateConfig]);
+    siteConfigSynced,
+    setSiteConfigSynced,
+  }), [config, updateConfig, siteConfigSynced]);
 
   // Apply global SEO meta tags
   useGlobalSeo(config);
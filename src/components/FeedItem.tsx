@@ -17,7 +17,9 @@ import { useCurrentUser } from '@/hooks/useCurrentUser';
 import { useNostrPublish } from '@/hooks/useNostrPublish';
 import { useAppContext } from '@/hooks/useAppContext';
 import { genUserName } from '@/lib/genUserName';
+import { getContentWarning } from '@/lib/contentWarning';
 import { NoteContent } from './NoteContent';
+import { SensitiveContent } from './SensitiveContent';
 import { ZapButton } from './ZapButton';
 import { Avatar, AvatarFallback, AvatarImage } from './ui/avatar';
 import { Button } from './ui/button';
@@ -50,6 +52,7 @@ export function FeedItem({ event, showActions = true }: FeedItemProps) {
   const author = useAuthor(event.pubkey);
   const [showReplyForm, setShowReplyForm] = useState(false);
   const [showRawEvent, setShowRawEvent] = useState(false);
+  const contentWarning = getContentWarning(event);
   
   const metadata = author.data?.metadata;
   const displayName = metadata?.name || metadata?.display_name || genUserName(event.pubkey);
@@ -211,7 +214,9 @@ export function FeedItem({ event, showActions = true }: FeedItemProps) {
       </Dialog>
 
       <CardContent className="px-4 pb-4 pt-0">
-        <NoteContent event={event} className="text-base" />
+        <SensitiveContent warning={contentWarning}>
+          <NoteContent event={event} className="text-base" />
+        </SensitiveContent>
       </CardContent>
 
       {showActions && (
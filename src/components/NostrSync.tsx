@@ -17,7 +17,7 @@ import { isBlockedRelay } from '@/lib/blockedRelays';
 export function NostrSync() {
   const { nostr } = useNostr();
   const { user } = useCurrentUser();
-  const { config, updateConfig } = useAppContext();
+  const { config, updateConfig, setSiteConfigSynced } = useAppContext();
   const masterPubkey = getMasterPubkey();
   const hasSyncedConfig = useRef(false);
 
@@ -67,7 +67,11 @@ export function NostrSync() {
 
   // Sync site configuration from Master User
   useEffect(() => {
-    if (!masterPubkey || hasSyncedConfig.current) return;
+    if (!masterPubkey) {
+      setSiteConfigSynced(true);
+      return;
+    }
+    if (hasSyncedConfig.current) return;
 
     const syncSiteConfigFromMaster = async () => {
       try {
@@ -139,7 +143,9 @@ export function NostrSync() {
             heroSubtitle: 'hero_subtitle',
             heroBackground: 'hero_background',
             defaultRelay: 'default_relay',
-            tweakcnThemeUrl: 'tweakcn_theme_url'
+            tweakcnThemeUrl: 'tweakcn_theme_url',
+            defaultLicense: 'default_license',
+            feedTitle: 'feed_title'
           };
 
           const eventTags = event.tags || [];
@@ -181,6 +187,9 @@ export function NostrSync() {
           const maxBlogPosts = eventTags.find(([name]) => name === 'max_blog_posts')?.[1];
           if (maxBlogPosts !== undefined) loadedConfig.maxBlogPosts = parseInt(maxBlogPosts);
 
+          const staleAfterDays = eventTags.find(([name]) => name === 'stale_after_days')?.[1];
+          if (staleAfterDays !== undefined && parseInt(staleAfterDays) > 0) loadedConfig.staleAfterDays = parseInt(staleAfterDays);
+
           const feedNpubsTag = eventTags.find(([name]) => name === 'feed_npubs')?.[1];
           if (feedNpubsTag) {
             try {
@@ -197,6 +206,9 @@ export function NostrSync() {
           const readOnlyTag = eventTags.find(([name]) => name === 'read_only_admin_access')?.[1];
           if (readOnlyTag !== undefined) loadedConfig.readOnlyAdminAccess = readOnlyTag === 'true';
 
+          const blockAiTag = eventTags.find(([name]) => name === 'block_ai_crawlers')?.[1];
+          if (blockAiTag !== undefined) loadedConfig.blockAiCrawlers = blockAiTag === 'true';
+
           const relaysTag = eventTags.find(([name]) => name === 'publish_relays')?.[1];
           if (relaysTag) {
             try {
@@ -246,8 +258,9 @@ export function NostrSync() {
       }
     };
 
-    syncSiteConfigFromMaster();
-  }, [masterPubkey, nostr, updateConfig, config.siteConfig?.updatedAt, config.siteConfig?.defaultRelay]);
+    // Pages that depend on admin roles wait for this, even when the fetch fails
+    syncSiteConfigFromMaster().finally(() => setSiteConfigSynced(true));
+  }, [masterPubkey, nostr, updateConfig, setSiteConfigSynced, config.siteConfig?.updatedAt, config.siteConfig?.defaultRelay]);
 
   return null;
 }
\ No newline at end of file
@@ -133,4 +133,47 @@ describe('NoteContent', () => {
     expect(linkText).not.toMatch(/^@npub1/); // Should not be a truncated npub
     expect(linkText).toEqual("@Swift Falcon");
   });
-});
\ No newline at end of file
+
+  it('renders NIP-30 custom emoji from emoji tags', () => {
+    const event: NostrEvent = {
+      id: 'test-id',
+      pubkey: 'test-pubkey',
+      created_at: Math.floor(Date.now() / 1000),
+      kind: 1,
+      tags: [['emoji', 'soapbox', 'https://example.com/soapbox.png']],
+      content: 'Hello :soapbox: and :unknown:',
+      sig: 'test-sig',
+    };
+
+    render(
+      <TestApp>
+        <NoteContent event={event} />
+      </TestApp>
+    );
+
+    const emoji = screen.getByRole('img', { name: ':soapbox:' });
+    expect(emoji).toHaveAttribute('src', 'https://example.com/soapbox.png');
+    expect(screen.getByText(/:unknown:/)).toBeInTheDocument();
+  });
+
+  it('keeps nostr mentions that directly follow a colon', () => {
+    const event: NostrEvent = {
+      id: 'test-id',
+      pubkey: 'test-pubkey',
+      created_at: Math.floor(Date.now() / 1000),
+      kind: 1,
+      tags: [['emoji', 'nostr', 'https://example.com/nostr.png']],
+      content: 'see:nostr:npub1zg69v7ys40x77y352eufp27daufrg4ncjz4ummcjx3t83y9tehhsqepuh0',
+      sig: 'test-sig',
+    };
+
+    render(
+      <TestApp>
+        <NoteContent event={event} />
+      </TestApp>
+    );
+
+    expect(screen.getByRole('link')).toHaveTextContent('@Swift Falcon');
+    expect(screen.queryByRole('img')).not.toBeInTheDocument();
+  });
+});
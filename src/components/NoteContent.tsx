@@ -41,7 +41,18 @@ function isVideoUrl(url: string) {
   }
 }
 
-/** Parses content of text note events so that URLs and hashtags are linkified. */
+/** NIP-30 `emoji` tags mapped shortcode → image URL. */
+function getCustomEmojis(tags: string[][]): Map<string, string> {
+  const emojis = new Map<string, string>();
+  for (const [name, shortcode, url] of tags) {
+    if (name === 'emoji' && shortcode && /^https?:\/\//i.test(url ?? '')) {
+      emojis.set(shortcode, url);
+    }
+  }
+  return emojis;
+}
+
+/** Parses content of text note events so that URLs, hashtags and custom emoji are rendered. */
 export function NoteContent({
   event,
   className,
@@ -58,9 +69,13 @@ export function NoteContent({
     const urlRegex = /(https?:\/\/[^\s]+)/gi;
     const nostrRegex = /nostr:(npub1|note1|nprofile1|nevent1|naddr1|nrelay1)([023456789acdefghjklmnpqrstuvwxyz]+)/gi;
     const hashtagRegex = /(#\w+)/g;
+    // The opening colon must not follow a word character, or `see:nostr:npub1…`
+    // would lose its mention to a `:nostr:` shortcode
+    const emojiRegex = /(?<!\w):([a-zA-Z0-9_-]+):/g;
+    const emojis = getCustomEmojis(event.tags);
 
     // Combined regex for splitting
-    const regex = new RegExp(`${urlRegex.source}|${nostrRegex.source}|${hashtagRegex.source}`, 'gi');
+    const regex = new RegExp(`${urlRegex.source}|${nostrRegex.source}|${hashtagRegex.source}|${emojiRegex.source}`, 'gi');
 
     const parts: React.ReactNode[] = [];
     let lastIndex = 0;
@@ -73,6 +88,7 @@ export function NoteContent({
       const nostrPrefix = match[2];
       const nostrData = match[3];
       const hashtag = match[4];
+      const shortcode = match[5];
       const index = match.index;
 
       // Add text before this match
@@ -173,6 +189,23 @@ export function NoteContent({
           // If decoding fails, just render as text
           parts.push(fullMatch);
         }
+      } else if (shortcode) {
+        // Handle NIP-30 custom emoji; unknown shortcodes stay as plain text
+        const emojiUrl = emojis.get(shortcode);
+        if (emojiUrl) {
+          parts.push(
+            <img
+              key={`emoji-${keyCounter++}`}
+              src={emojiUrl}
+              alt={fullMatch}
+              title={fullMatch}
+              referrerPolicy="no-referrer"
+              className="inline h-[1.2em] w-auto align-text-bottom"
+            />
+          );
+        } else {
+          parts.push(fullMatch);
+        }
       } else if (hashtag) {
         // Handle hashtags
         const tag = hashtag.slice(1); // Remove the #
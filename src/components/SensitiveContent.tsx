@@ -0,0 +1,36 @@
+import { useState, type ReactNode } from 'react';
+import { EyeOff } from 'lucide-react';
+import { Button } from '@/components/ui/button';
+
+interface SensitiveContentProps {
+  /** Result of `getContentWarning`: `undefined` renders the children as-is */
+  warning: string | undefined;
+  children: ReactNode;
+}
+
+/** Blurs NIP-36 flagged content behind a click-through. */
+export function SensitiveContent({ warning, children }: SensitiveContentProps) {
+  const [revealed, setRevealed] = useState(false);
+
+  if (warning === undefined || revealed) {
+    return <>{children}</>;
+  }
+
+  return (
+    <div className="relative overflow-hidden rounded-md">
+      <div className="blur-lg select-none pointer-events-none max-h-96 overflow-hidden" aria-hidden="true">
+        {children}
+      </div>
+      <div className="absolute inset-0 flex flex-col items-center justify-center gap-2 bg-background/60 text-center p-4">
+        <EyeOff className="h-5 w-5 text-muted-foreground" />
+        <p className="text-sm font-medium">Sensitive content</p>
+        {warning && (
+          <p className="text-xs text-muted-foreground">{warning}</p>
+        )}
+        <Button variant="outline" size="sm" onClick={() => setRevealed(true)}>
+          Show
+        </Button>
+      </div>
+    </div>
+  );
+}
@@ -12,7 +12,7 @@ import { useCurrentUser } from '@/hooks/useCurrentUser';
 import { useDefaultRelay } from '@/hooks/useDefaultRelay';
 import { useToast } from '@/hooks/useToast';
 import { Checkbox } from '@/components/ui/checkbox';
-import { Plus, Edit, Trash2, Eye, Layout, Share2, Search, Image as ImageIcon, Library, Loader2, Clock, Filter, RefreshCw } from 'lucide-react';
+import { Plus, Edit, Trash2, Eye, Layout, Share2, Search, Image as ImageIcon, Library, Loader2, Clock, Filter, RefreshCw, Pin, PinOff, ArrowUp, ArrowDown, History } from 'lucide-react';
 import { MediaSelectorDialog } from './MediaSelectorDialog';
 import { SchedulePicker } from './SchedulePicker';
 import { useCreateScheduledPost, useUpdateScheduledPost } from '@/hooks/useScheduledPosts';
@@ -21,7 +21,10 @@ import type { ScheduleConfig } from '@/components/admin/SchedulePicker';
 import type { NostrEvent } from '@/types/scheduled';
 import { BlossomUploader } from '@nostrify/nostrify/uploaders';
 import { useAppContext } from '@/hooks/useAppContext';
-import { useRemoteNostrJson } from '@/hooks/useRemoteNostrJson';
+import { useRemoteNostrJson, useAdminAuth } from '@/hooks/useRemoteNostrJson';
+import { useFeaturedContent, useUpdateFeaturedContent } from '@/hooks/useFeaturedContent';
+import { getArticleCoordinate, moveFeatured, toggleFeatured, type FeaturedList } from '@/lib/featured';
+import { getLicense, getLicenseTags } from '@/lib/license';
 import {
   Tooltip,
   TooltipContent,
@@ -46,6 +49,20 @@ interface BlogPost {
   d: string;
   pubkey: string;
   kind: number;
+  license?: string;
+}
+
+/** Used when the site config has no `stale_after_days` tag. */
+const DEFAULT_STALE_AFTER_DAYS = 365;
+
+function getDaysSinceUpdate(post: BlogPost): number {
+  // created_at of a replaceable event is the time of its latest edit
+  return Math.floor((Date.now() / 1000 - post.created_at) / 86400);
+}
+
+/** Published posts not updated for `staleAfterDays` are flagged for review. */
+function isStale(post: BlogPost, staleAfterDays: number): boolean {
+  return post.kind === 30023 && post.published && getDaysSinceUpdate(post) >= staleAfterDays;
 }
 
 function AuthorInfo({ pubkey }: { pubkey: string }) {
@@ -84,12 +101,18 @@ function AuthorInfo({ pubkey }: { pubkey: string }) {
   );
 }
 
-function BlogPostCard({ post, user, usernameSearch, onEdit, onDelete }: {
+function BlogPostCard({ post, user, usernameSearch, onEdit, onDelete, featuredIndex, canFeature, onToggleFeatured, onMoveFeatured, staleAfterDays }: {
   post: BlogPost;
   user: { pubkey: string } | undefined;
   usernameSearch: string;
   onEdit: (post: BlogPost) => void;
   onDelete: (post: BlogPost) => void;
+  /** Position in the front-page curation list, -1 when not featured */
+  featuredIndex: number;
+  canFeature: boolean;
+  onToggleFeatured: (post: BlogPost) => void;
+  onMoveFeatured: (post: BlogPost, offset: -1 | 1) => void;
+  staleAfterDays: number;
 }) {
   const { data: author } = useAuthor(post.pubkey);
 
@@ -114,6 +137,22 @@ function BlogPostCard({ post, user, usernameSearch, onEdit, onDelete }: {
               <Badge variant="outline" className="text-[10px] font-mono">
                 Kind {post.kind}
               </Badge>
+              {featuredIndex !== -1 && (
+                <Badge variant="secondary" className="gap-1">
+                  <Pin className="h-3 w-3" />
+                  Featured #{featuredIndex + 1}
+                </Badge>
+              )}
+              {isStale(post, staleAfterDays) && (
+                <Badge
+                  variant="outline"
+                  className="gap-1 border-amber-500/50 text-amber-600 dark:text-amber-400"
+                  title={`Not updated in ${getDaysSinceUpdate(post)} days`}
+                >
+                  <History className="h-3 w-3" />
+                  Needs review
+                </Badge>
+              )}
             </div>
             <AuthorInfo pubkey={post.pubkey} />
             <p className="text-sm text-muted-foreground line-clamp-2">
@@ -124,6 +163,28 @@ function BlogPostCard({ post, user, usernameSearch, onEdit, onDelete }: {
             </p>
           </div>
           <div className="flex gap-2 ml-4">
+            {canFeature && post.kind === 30023 && post.published && (
+              <>
+                {featuredIndex !== -1 && (
+                  <>
+                    <Button variant="ghost" size="sm" onClick={() => onMoveFeatured(post, -1)} title="Move up">
+                      <ArrowUp className="h-4 w-4" />
+                    </Button>
+                    <Button variant="ghost" size="sm" onClick={() => onMoveFeatured(post, 1)} title="Move down">
+                      <ArrowDown className="h-4 w-4" />
+                    </Button>
+                  </>
+                )}
+                <Button
+                  variant="ghost"
+                  size="sm"
+                  onClick={() => onToggleFeatured(post)}
+                  title={featuredIndex !== -1 ? 'Unpin from front page' : 'Pin to front page'}
+                >
+                  {featuredIndex !== -1 ? <PinOff className="h-4 w-4" /> : <Pin className="h-4 w-4" />}
+                </Button>
+              </>
+            )}
             {user && post.pubkey === user.pubkey && (
               <>
                 <Button variant="ghost" size="sm" onClick={() => onEdit(post)}>
@@ -149,6 +210,9 @@ export default function AdminBlog() {
   const { mutateAsync: publishEvent } = useNostrPublish();
   const { toast } = useToast();
   const { data: remoteNostrJson } = useRemoteNostrJson();
+  const { isMaster } = useAdminAuth(user?.pubkey);
+  const { data: featuredList } = useFeaturedContent();
+  const { mutateAsync: updateFeatured } = useUpdateFeaturedContent();
   const [isCreating, setIsCreating] = useState(false);
   const [isRefreshing, setIsRefreshing] = useState(false);
   const [editingPost, setEditingPost] = useState<BlogPost | null>(null);
@@ -156,10 +220,14 @@ export default function AdminBlog() {
   const [selectedRelays, setSelectedRelays] = useState<string[]>([]);
   const [usernameSearch, setUsernameSearch] = useState('');
   const [filterByNostrJson, setFilterByNostrJson] = useState(false);
+  const [showStaleOnly, setShowStaleOnly] = useState(false);
+  const defaultLicense = config.siteConfig?.defaultLicense || '';
+  const staleAfterDays = config.siteConfig?.staleAfterDays || DEFAULT_STALE_AFTER_DAYS;
   const [formData, setFormData] = useState({
     title: '',
     content: '',
     published: false,
+    license: defaultLicense,
   });
   const [showMediaSelector, setShowMediaSelector] = useState(false);
   const [isUploading, setIsUploading] = useState(false);
@@ -208,6 +276,7 @@ export default function AdminBlog() {
         title: editingScheduledPost.title || '',
         content: editingScheduledPost.content || '',
         published: true, // Blog posts are always published when scheduled
+        license: getLicense(editingScheduledPost.tags || []) || '',
       });
       setEditingScheduledPostId(editingScheduledPost.scheduledPostId);
       setScheduleConfig({
@@ -345,6 +414,7 @@ export default function AdminBlog() {
         let title = tags.find(([name]) => name === 'title')?.[1] || 'Untitled';
         let published = tags.find(([name]) => name === 'published')?.[1] === 'true' || !tags.find(([name]) => name === 'published');
         let d = tags.find(([name]) => name === 'd')?.[1] || event.id;
+        let license = getLicense(tags);
 
         // Handle Kind 31234 (NIP-37 Draft Wraps)
         if (event.kind === 31234) {
@@ -357,6 +427,7 @@ export default function AdminBlog() {
               const draftTags = draftEvent.tags || [];
               title = draftTags.find(([name]: string[]) => name === 'title')?.[1] || title;
               d = draftTags.find(([name]: string[]) => name === 'd')?.[1] || d;
+              license = getLicense(draftTags) || license;
             } else if (user?.signer?.nip04) {
               const decrypted = await user.signer.nip04.decrypt(user.pubkey, event.content);
               const draftEvent = JSON.parse(decrypted);
@@ -364,6 +435,7 @@ export default function AdminBlog() {
               const draftTags = draftEvent.tags || [];
               title = draftTags.find(([name]: string[]) => name === 'title')?.[1] || title;
               d = draftTags.find(([name]: string[]) => name === 'd')?.[1] || d;
+              license = getLicense(draftTags) || license;
             } else {
               // Try to parse as unencrypted JSON if no decryption available
               try {
@@ -372,6 +444,7 @@ export default function AdminBlog() {
                 const draftTags = draftEvent.tags || [];
                 title = draftTags.find(([name]: string[]) => name === 'title')?.[1] || title;
                 d = draftTags.find(([name]: string[]) => name === 'd')?.[1] || d;
+                license = getLicense(draftTags) || license;
               } catch {
                 content = "[Encrypted Draft]";
               }
@@ -391,6 +464,7 @@ export default function AdminBlog() {
           d,
           pubkey: event.pubkey,
           kind: event.kind,
+          license,
         };
       }));
 
@@ -435,7 +509,7 @@ export default function AdminBlog() {
 
   // Check if form is dirty
   const isDirty = editingPost
-    ? (formData.title !== editingPost.title || formData.content !== editingPost.content || formData.published !== editingPost.published)
+    ? (formData.title !== editingPost.title || formData.content !== editingPost.content || formData.published !== editingPost.published || formData.license !== (editingPost.license || ''))
     : (formData.title.trim() !== '' || formData.content.trim() !== '');
 
   // Prevent accidental navigation
@@ -457,7 +531,7 @@ export default function AdminBlog() {
     setIsCreating(false);
     setEditingPost(null);
     setEditingScheduledPostId(null);
-    setFormData({ title: '', content: '', published: false });
+    setFormData({ title: '', content: '', published: false, license: defaultLicense });
     setScheduleConfig({ enabled: false, scheduledFor: null });
   };
 
@@ -486,6 +560,7 @@ export default function AdminBlog() {
           ['title', formData.title],
           ['published', 'true'],
           ['published_at', created_at.toString()],
+          ...getLicenseTags(formData.license),
         ];
 
         // Create and sign the event with future timestamp
@@ -544,7 +619,7 @@ export default function AdminBlog() {
           });
         }
 
-        setFormData({ title: '', content: '', published: false });
+        setFormData({ title: '', content: '', published: false, license: defaultLicense });
         setIsCreating(false);
         setEditingPost(null);
         setEditingScheduledPostId(null);
@@ -569,6 +644,7 @@ export default function AdminBlog() {
         ['d', dTag],
         ['title', formData.title],
         ['published', formData.published.toString()],
+        ...getLicenseTags(formData.license),
       ];
 
       if (formData.published) {
@@ -648,7 +724,7 @@ export default function AdminBlog() {
       }
 
       // Reset form
-      setFormData({ title: '', content: '', published: false });
+      setFormData({ title: '', content: '', published: false, license: defaultLicense });
       setIsCreating(false);
       setEditingPost(null);
       setScheduleConfig({ enabled: false, scheduledFor: null });
@@ -664,6 +740,35 @@ export default function AdminBlog() {
     }
   };
 
+  const getFeaturedIndex = (post: BlogPost) => {
+    const coordinate = getArticleCoordinate(post.pubkey, post.d);
+    return featuredList?.items.indexOf(coordinate) ?? -1;
+  };
+
+  const saveFeatured = async (update: (list: FeaturedList) => FeaturedList['items']) => {
+    try {
+      await updateFeatured(update);
+    } catch (error: unknown) {
+      console.error('Failed to update featured posts:', error);
+      const errorMessage = error instanceof Error ? error.message : "Failed to update featured posts.";
+      toast({
+        title: "Error",
+        description: errorMessage,
+        variant: "destructive"
+      });
+    }
+  };
+
+  const handleToggleFeatured = (post: BlogPost) => {
+    const coordinate = getArticleCoordinate(post.pubkey, post.d);
+    void saveFeatured((list) => toggleFeatured(list, coordinate));
+  };
+
+  const handleMoveFeatured = (post: BlogPost, offset: -1 | 1) => {
+    const coordinate = getArticleCoordinate(post.pubkey, post.d);
+    void saveFeatured((list) => moveFeatured(list, coordinate, offset));
+  };
+
   const handleEdit = (post: BlogPost) => {
     if (user && post.pubkey !== user.pubkey) {
       toast({
@@ -677,6 +782,7 @@ export default function AdminBlog() {
       title: post.title,
       content: post.content,
       published: post.published,
+      license: post.license || '',
     });
     setEditingPost(post);
     setIsCreating(true);
@@ -748,6 +854,19 @@ export default function AdminBlog() {
                   />
                 </div>
 
+                <div>
+                  <Label htmlFor="license">License</Label>
+                  <Input
+                    id="license"
+                    value={formData.license}
+                    onChange={(e) => setFormData(prev => ({ ...prev, license: e.target.value }))}
+                    placeholder={defaultLicense || 'CC-BY-4.0'}
+                  />
+                  <p className="text-xs text-muted-foreground mt-1">
+                    SPDX identifier such as CC-BY-4.0. Leave empty to publish without a license label.
+                  </p>
+                </div>
+
                 <div>
                   <Label htmlFor="content">Content (Markdown)</Label>
                   <Tabs defaultValue="edit" className="mt-2">
@@ -970,13 +1089,27 @@ export default function AdminBlog() {
                   Show only users from nostr.json
                 </Label>
               </div>
+              <div className="flex items-center gap-2 mt-3">
+                <Switch
+                  id="filter-stale-blog"
+                  checked={showStaleOnly}
+                  onCheckedChange={setShowStaleOnly}
+                />
+                <Label htmlFor="filter-stale-blog" className="text-sm cursor-pointer flex items-center gap-2">
+                  <History className="h-3 w-3" />
+                  Show only posts needing review ({posts?.filter(post => isStale(post, staleAfterDays)).length ?? 0})
+                </Label>
+              </div>
             </div>
             <div className="flex items-center gap-2">
               <Button variant="outline" onClick={handleRefresh} disabled={isRefreshing}>
                 <RefreshCw className={`h-4 w-4 mr-2 ${isRefreshing ? 'animate-spin' : ''}`} />
                 Refresh
               </Button>
-              <Button onClick={() => setIsCreating(true)}>
+              <Button onClick={() => {
+                setFormData({ title: '', content: '', published: false, license: defaultLicense });
+                setIsCreating(true);
+              }}>
                 <Plus className="h-4 w-4 mr-2" />
                 New Post
               </Button>
@@ -984,7 +1117,7 @@ export default function AdminBlog() {
           </div>
 
           <div className="space-y-4">
-            {posts?.map((post) => (
+            {posts?.filter(post => !showStaleOnly || isStale(post, staleAfterDays)).map((post) => (
               <BlogPostCard
                 key={post.id}
                 post={post}
@@ -992,6 +1125,11 @@ export default function AdminBlog() {
                 usernameSearch={usernameSearch}
                 onEdit={handleEdit}
                 onDelete={handleDelete}
+                featuredIndex={getFeaturedIndex(post)}
+                canFeature={isMaster && !!featuredList}
+                onToggleFeatured={handleToggleFeatured}
+                onMoveFeatured={handleMoveFeatured}
+                staleAfterDays={staleAfterDays}
               />
             ))}
 
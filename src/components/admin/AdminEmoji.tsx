@@ -0,0 +1,201 @@
+import { useRef, useState } from 'react';
+import { Card, CardContent, CardHeader, CardTitle, CardDescription } from '@/components/ui/card';
+import { Button } from '@/components/ui/button';
+import { Input } from '@/components/ui/input';
+import { Label } from '@/components/ui/label';
+import { AlertCircle, Loader2, Smile, Trash2, Upload } from 'lucide-react';
+import { useCurrentUser } from '@/hooks/useCurrentUser';
+import { useAdminAuth } from '@/hooks/useRemoteNostrJson';
+import { useEmojiSet, useUpdateEmojiSet } from '@/hooks/useEmojiSet';
+import { useUploadFile } from '@/hooks/useUploadFile';
+import { useToast } from '@/hooks/useToast';
+import { isValidShortcode, type EmojiSet } from '@/lib/emoji';
+
+/**
+ * Editor for the site's custom emoji set (kind 30030). Images go to the
+ * configured Blossom servers; the set itself is published by the master user.
+ */
+export default function AdminEmoji() {
+  const { user } = useCurrentUser();
+  const { isMaster } = useAdminAuth(user?.pubkey);
+  const { data: emojiSet, isLoading } = useEmojiSet();
+  const { mutateAsync: updateEmojiSet, isPending: isSaving } = useUpdateEmojiSet();
+  const { mutateAsync: uploadFile, isPending: isUploading } = useUploadFile();
+  const { toast } = useToast();
+  const [shortcode, setShortcode] = useState('');
+  const [file, setFile] = useState<File | null>(null);
+  const fileInputRef = useRef<HTMLInputElement>(null);
+
+  const saveEmojiSet = async (update: (set: EmojiSet) => EmojiSet['emojis']) => {
+    try {
+      await updateEmojiSet(update);
+      return true;
+    } catch (error: unknown) {
+      console.error('Failed to update emoji set:', error);
+      const errorMessage = error instanceof Error ? error.message : "Failed to update emoji set.";
+      toast({
+        title: "Error",
+        description: errorMessage,
+        variant: "destructive"
+      });
+      return false;
+    }
+  };
+
+  const handleAdd = async () => {
+    const code = shortcode.trim();
+    if (!file || !isValidShortcode(code)) return;
+
+    if (emojiSet?.emojis.some((emoji) => emoji.shortcode === code)) {
+      toast({ title: "Error", description: `:${code}: already exists`, variant: "destructive" });
+      return;
+    }
+
+    let url: string | undefined;
+    try {
+      const tags = await uploadFile(file);
+      url = tags.find(([name]) => name === 'url')?.[1];
+    } catch (error: unknown) {
+      console.error('Emoji upload failed:', error);
+      toast({
+        title: "Upload Failed",
+        description: error instanceof Error ? error.message : "Failed to upload image.",
+        variant: "destructive"
+      });
+      return;
+    }
+    if (!url) return;
+
+    const emojiUrl = url;
+    const saved = await saveEmojiSet((set) => [
+      ...set.emojis.filter((emoji) => emoji.shortcode !== code),
+      { shortcode: code, url: emojiUrl },
+    ]);
+    if (saved) {
+      setShortcode('');
+      setFile(null);
+      if (fileInputRef.current) fileInputRef.current.value = '';
+      toast({ title: "Success", description: `Added :${code}:` });
+    }
+  };
+
+  const handleRemove = (code: string) => {
+    void saveEmojiSet((set) => set.emojis.filter((emoji) => emoji.shortcode !== code));
+  };
+
+  const shortcodeInvalid = shortcode.trim() !== '' && !isValidShortcode(shortcode.trim());
+  const isBusy = isUploading || isSaving;
+
+  return (
+    <div className="space-y-6">
+      <div>
+        <h2 className="text-2xl font-bold tracking-tight">Custom Emoji</h2>
+        <p className="text-muted-foreground">
+          Upload images for the site's emoji set. Use them in notes as <code>:shortcode:</code>.
+        </p>
+      </div>
+
+      {!isMaster && (
+        <div className="flex items-center gap-2 p-4 rounded-lg border bg-muted/30 text-sm text-muted-foreground">
+          <AlertCircle className="h-4 w-4 shrink-0" />
+          Only the master user can change the site's emoji set.
+        </div>
+      )}
+
+      {isMaster && (
+        <Card>
+          <CardHeader>
+            <CardTitle className="flex items-center gap-2">
+              <Upload className="h-5 w-5" />
+              Add Emoji
+            </CardTitle>
+            <CardDescription>
+              The image is uploaded to your first Blossom server.
+            </CardDescription>
+          </CardHeader>
+          <CardContent className="space-y-4">
+            <div className="grid gap-4 sm:grid-cols-2">
+              <div className="space-y-2">
+                <Label htmlFor="emoji-shortcode">Shortcode</Label>
+                <Input
+                  id="emoji-shortcode"
+                  placeholder="party_parrot"
+                  value={shortcode}
+                  onChange={(e) => setShortcode(e.target.value)}
+                />
+                {shortcodeInvalid && (
+                  <p className="text-xs text-destructive">Use letters, numbers, hyphens and underscores only.</p>
+                )}
+              </div>
+              <div className="space-y-2">
+                <Label htmlFor="emoji-image">Image</Label>
+                <Input
+                  id="emoji-image"
+                  type="file"
+                  accept="image/*"
+                  ref={fileInputRef}
+                  onChange={(e) => setFile(e.target.files?.[0] ?? null)}
+                />
+              </div>
+            </div>
+            <Button onClick={handleAdd} disabled={isBusy || !file || !shortcode.trim() || shortcodeInvalid}>
+              {isBusy ? <Loader2 className="h-4 w-4 mr-2 animate-spin" /> : <Upload className="h-4 w-4 mr-2" />}
+              Add Emoji
+            </Button>
+          </CardContent>
+        </Card>
+      )}
+
+      <Card>
+        <CardHeader>
+          <CardTitle className="flex items-center gap-2">
+            <Smile className="h-5 w-5" />
+            Emoji Set
+          </CardTitle>
+          <CardDescription>
+            Published as a kind 30030 emoji set on the default relay.
+          </CardDescription>
+        </CardHeader>
+        <CardContent>
+          {isLoading ? (
+            <div className="flex justify-center py-6">
+              <Loader2 className="h-6 w-6 animate-spin text-muted-foreground" />
+            </div>
+          ) : emojiSet && emojiSet.emojis.length > 0 ? (
+            <div className="grid gap-2 sm:grid-cols-2 lg:grid-cols-3">
+              {emojiSet.emojis.map((emoji) => (
+                <div key={emoji.shortcode} className="flex items-center justify-between p-2 rounded-md border bg-card/50">
+                  <div className="flex items-center gap-3 overflow-hidden">
+                    <img
+                      src={emoji.url}
+                      alt={`:${emoji.shortcode}:`}
+                      referrerPolicy="no-referrer"
+                      className="h-8 w-8 object-contain shrink-0"
+                    />
+                    <span className="text-sm font-mono truncate">:{emoji.shortcode}:</span>
+                  </div>
+                  {isMaster && (
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      className="h-8 w-8 text-destructive"
+                      onClick={() => handleRemove(emoji.shortcode)}
+                      disabled={isSaving}
+                      title="Remove"
+                    >
+                      <Trash2 className="h-4 w-4" />
+                    </Button>
+                  )}
+                </div>
+              ))}
+            </div>
+          ) : (
+            <div className="text-center py-6 text-muted-foreground border border-dashed rounded-lg">
+              No custom emoji yet.
+            </div>
+          )}
+        </CardContent>
+      </Card>
+    </div>
+  );
+}
@@ -14,7 +14,7 @@ import { useToast } from '@/hooks/useToast';
 import { useCurrentUser } from '@/hooks/useCurrentUser';
 import { useRemoteNostrJson } from '@/hooks/useRemoteNostrJson';
 import { getMasterPubkey, getSiteConfigDTag } from '@/lib/relay';
-import { formatPubkey } from '@/lib/utils';
+import { formatPubkey, normalizeToHexPubkeys } from '@/lib/utils';
 import { useAuthor } from '@/hooks/useAuthor';
 import { Avatar, AvatarImage, AvatarFallback } from '@/components/ui/avatar';
 import { User } from 'lucide-react';
@@ -137,6 +137,10 @@ export default function AdminFeed() {
         ['feed_read_from_publish_relays', readFromPublishRelays.toString()],
         ['tweakcn_theme_url', updatedConfig.tweakcnThemeUrl || ''],
         ['section_order', JSON.stringify(updatedConfig.sectionOrder || [])],
+        ['block_ai_crawlers', (updatedConfig.blockAiCrawlers ?? false).toString()],
+        ['default_license', updatedConfig.defaultLicense || ''],
+        ['feed_title', updatedConfig.feedTitle || ''],
+        ['stale_after_days', (updatedConfig.staleAfterDays ?? 365).toString()],
         ['updated_at', updatedConfig.updatedAt.toString()],
       ];
 
@@ -262,10 +266,20 @@ export default function AdminFeed() {
                   type="button"
                   disabled={!canManageFeed}
                   onClick={() => {
-                    if (newNpub.trim()) {
-                      setFeedNpubs(prev => [...new Set([...prev, newNpub.trim()])]);
-                      setNewNpub('');
+                    if (!newNpub.trim()) return;
+                    // nip19.decode verifies the bech32 checksum, so typos are rejected here
+                    // instead of being silently dropped by the feed later
+                    const [pubkey] = normalizeToHexPubkeys([newNpub]);
+                    if (!pubkey) {
+                      toast({
+                        title: "Invalid key",
+                        description: "Enter a valid npub or 64-character hex public key.",
+                        variant: "destructive",
+                      });
+                      return;
                     }
+                    setFeedNpubs(prev => [...new Set([...prev, pubkey])]);
+                    setNewNpub('');
                   }}
                 >
                   <UserPlus className="h-4 w-4 mr-2" />
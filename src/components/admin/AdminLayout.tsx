@@ -28,6 +28,7 @@ import {
   Rss,
   Zap,
   FileImage,
+  Smile,
   MessageCircle,
   HelpCircle,
   Clock,
@@ -101,6 +102,7 @@ export default function AdminLayout() {
     { name: 'Feed', href: '/admin/feed', icon: Rss },
     { name: 'Zaplytics', href: '/admin/zaplytics', icon: Zap },
     { name: 'Media', href: '/admin/media', icon: FileImage },
+    { name: 'Emoji', href: '/admin/emoji', icon: Smile },
     { name: 'Pages', href: '/admin/pages', icon: FileCode },
     { name: 'Forms', href: '/admin/forms', icon: ClipboardList },
     { name: 'Sync Content', href: '/admin/sync-content', icon: RefreshCw },
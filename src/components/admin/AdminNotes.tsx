@@ -39,6 +39,8 @@ import { MediaSelectorDialog } from './MediaSelectorDialog';
 import { SchedulePicker } from './SchedulePicker';
 import { useCreateScheduledPost, useUpdateScheduledPost } from '@/hooks/useScheduledPosts';
 import { useSchedulerHealth } from '@/hooks/useSchedulerHealth';
+import { useEmojiSet } from '@/hooks/useEmojiSet';
+import { getEmojiTags, EMPTY_EMOJI_SET } from '@/lib/emoji';
 import type { ScheduleConfig } from '@/components/admin/SchedulePicker';
 import type { NostrEvent } from '@/types/scheduled';
 import {
@@ -275,6 +277,7 @@ export default function AdminNotes() {
   const { mutateAsync: publishEvent, isPending } = useNostrPublish();
   const { config } = useAppContext();
   const { toast } = useToast();
+  const { data: emojiSet = EMPTY_EMOJI_SET } = useEmojiSet();
 
   const [activeTab, setActiveTab] = useState<'drafts' | 'published'>('published');
   const [isCreating, setIsCreating] = useState(false);
@@ -604,6 +607,9 @@ export default function AdminNotes() {
   const handleSubmit = async (asDraft: boolean) => {
     if (!user || !content.trim()) return;
 
+    // NIP-30 tags for any of the site's emoji used in the note
+    const emojiTags = getEmojiTags(content, emojiSet.emojis);
+
     // If scheduling is enabled and not saving as draft
     if (scheduleConfig.enabled && scheduleConfig.scheduledFor && !asDraft) {
       try {
@@ -615,7 +621,7 @@ export default function AdminNotes() {
         const signedEvent = await user.signer.signEvent({
           kind: 1,
           content: content,
-          tags: [],
+          tags: emojiTags,
           created_at,
         }) as NostrEvent;
 
@@ -676,7 +682,7 @@ export default function AdminNotes() {
         const draftEvent = {
           kind: 1,
           content: content,
-          tags: [],
+          tags: emojiTags,
           created_at: Math.floor(Date.now() / 1000),
         };
 
@@ -709,7 +715,7 @@ export default function AdminNotes() {
           event: {
             kind: 1,
             content: content,
-            tags: [],
+            tags: emojiTags,
           },
           relays: selectedRelays,
         });
@@ -775,6 +781,23 @@ export default function AdminNotes() {
     }
   };
 
+  const insertEmoji = (emoji: string) => {
+    const textarea = textareaRef.current;
+    if (textarea) {
+      const start = textarea.selectionStart;
+      const end = textarea.selectionEnd;
+      const newContent = content.slice(0, start) + emoji + content.slice(end);
+      setContent(newContent);
+      setTimeout(() => {
+        textarea.focus();
+        textarea.setSelectionRange(start + emoji.length, start + emoji.length);
+      }, 0);
+    } else {
+      setContent(prev => prev + emoji);
+    }
+    setShowEmojiPicker(false);
+  };
+
   const handleEdit = (note: Note) => {
     setEditingNote(note);
     setContent(note.content);
@@ -823,7 +846,7 @@ export default function AdminNotes() {
                   <div className="min-h-[200px] p-4 border rounded-md max-w-none bg-muted/30 overflow-auto">
                     {content ? (
                       <NoteContent
-                        event={{ content, kind: 1, tags: [], created_at: 0, id: '', pubkey: '', sig: '' }}
+                        event={{ content, kind: 1, tags: getEmojiTags(content, emojiSet.emojis), created_at: 0, id: '', pubkey: '', sig: '' }}
                       />
                     ) : (
                       <span className="text-muted-foreground italic">Nothing to preview</span>
@@ -949,27 +972,27 @@ export default function AdminNotes() {
                                 key={emoji}
                                 type="button"
                                 className="p-1 hover:bg-muted rounded text-lg transition-colors"
-                                onClick={() => {
-                                  const textarea = textareaRef.current;
-                                  if (textarea) {
-                                    const start = textarea.selectionStart;
-                                    const end = textarea.selectionEnd;
-                                    const newContent = content.slice(0, start) + emoji + content.slice(end);
-                                    setContent(newContent);
-                                    setTimeout(() => {
-                                      textarea.focus();
-                                      textarea.setSelectionRange(start + emoji.length, start + emoji.length);
-                                    }, 0);
-                                  } else {
-                                    setContent(prev => prev + emoji);
-                                  }
-                                  setShowEmojiPicker(false);
-                                }}
+                                onClick={() => insertEmoji(emoji)}
                               >
                                 {emoji}
                               </button>
                             ))}
                         </div>
+                        {emojiSet.emojis.length > 0 && (
+                          <div className="grid grid-cols-8 gap-1 mt-2 pt-2 border-t">
+                            {emojiSet.emojis.map(({ shortcode, url }) => (
+                              <button
+                                key={shortcode}
+                                type="button"
+                                className="p-1 hover:bg-muted rounded transition-colors"
+                                title={`:${shortcode}:`}
+                                onClick={() => insertEmoji(`:${shortcode}:`)}
+                              >
+                                <img src={url} alt={`:${shortcode}:`} referrerPolicy="no-referrer" className="h-5 w-5 object-contain" />
+                              </button>
+                            ))}
+                          </div>
+                        )}
                       </div>
                     )}
                   </div>
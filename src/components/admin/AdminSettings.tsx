@@ -76,6 +76,10 @@ interface SiteConfig {
   sectionOrder?: string[];
   nip19Gateway?: string;
   readOnlyAdminAccess: boolean;
+  blockAiCrawlers: boolean;
+  defaultLicense: string;
+  feedTitle: string;
+  staleAfterDays: number;
   updatedAt?: number;
 }
 
@@ -296,6 +300,10 @@ export default function AdminSettings() {
     nip19Gateway: config.siteConfig?.nip19Gateway ?? 'https://nostr.at',
     sectionOrder: config.siteConfig?.sectionOrder ?? ['navigation', 'basic', 'styling', 'hero', 'content'],
     readOnlyAdminAccess: config.siteConfig?.readOnlyAdminAccess ?? false,
+    blockAiCrawlers: config.siteConfig?.blockAiCrawlers ?? false,
+    defaultLicense: config.siteConfig?.defaultLicense ?? '',
+    feedTitle: config.siteConfig?.feedTitle ?? '',
+    staleAfterDays: config.siteConfig?.staleAfterDays ?? 365,
   }));
 
   const isDirty = useMemo(() => {
@@ -321,6 +329,10 @@ export default function AdminSettings() {
       siteConfig.defaultRelay !== (originalConfig.defaultRelay ?? getDefaultRelayUrl()) ||
       siteConfig.tweakcnThemeUrl !== (originalConfig.tweakcnThemeUrl ?? '') ||
       siteConfig.nip19Gateway !== (originalConfig.nip19Gateway ?? 'https://nostr.at') ||
+      siteConfig.blockAiCrawlers !== (originalConfig.blockAiCrawlers ?? false) ||
+      siteConfig.defaultLicense !== (originalConfig.defaultLicense ?? '') ||
+      siteConfig.feedTitle !== (originalConfig.feedTitle ?? '') ||
+      siteConfig.staleAfterDays !== (originalConfig.staleAfterDays ?? 365) ||
       JSON.stringify(siteConfig.sectionOrder) !== JSON.stringify(originalConfig.sectionOrder ?? ['navigation', 'basic', 'styling', 'hero', 'content']);
 
     const hasNavChanged = JSON.stringify(navigation) !== JSON.stringify(config.navigation || [
@@ -522,7 +534,9 @@ export default function AdminSettings() {
           tweakcnThemeUrl: 'tweakcn_theme_url',
           nip19Gateway: 'nip19_gateway',
           sectionOrder: 'section_order',
-          readOnlyAdminAccess: 'read_only_admin_access'
+          readOnlyAdminAccess: 'read_only_admin_access',
+          defaultLicense: 'default_license',
+          feedTitle: 'feed_title'
         };
 
         const eventTags = event.tags || [];
@@ -548,12 +562,18 @@ export default function AdminSettings() {
         const readOnlyAdminAccess = eventTags.find(([name]) => name === 'read_only_admin_access')?.[1];
         if (readOnlyAdminAccess !== undefined) loadedConfig.readOnlyAdminAccess = readOnlyAdminAccess === 'true';
 
+        const blockAiCrawlers = eventTags.find(([name]) => name === 'block_ai_crawlers')?.[1];
+        if (blockAiCrawlers !== undefined) loadedConfig.blockAiCrawlers = blockAiCrawlers === 'true';
+
         const maxEvents = eventTags.find(([name]) => name === 'max_events')?.[1];
         if (maxEvents !== undefined) loadedConfig.maxEvents = parseInt(maxEvents);
 
         const maxBlogPosts = eventTags.find(([name]) => name === 'max_blog_posts')?.[1];
         if (maxBlogPosts !== undefined) loadedConfig.maxBlogPosts = parseInt(maxBlogPosts);
 
+        const staleAfterDays = eventTags.find(([name]) => name === 'stale_after_days')?.[1];
+        if (staleAfterDays !== undefined && parseInt(staleAfterDays) > 0) loadedConfig.staleAfterDays = parseInt(staleAfterDays);
+
         const relaysTag = eventTags.find(([name]) => name === 'publish_relays')?.[1];
         if (relaysTag) {
           try {
@@ -686,6 +706,10 @@ export default function AdminSettings() {
         ['nip19_gateway', siteConfig.nip19Gateway || 'https://nostr.at'],
         ['section_order', JSON.stringify(siteConfig.sectionOrder)],
         ['read_only_admin_access', siteConfig.readOnlyAdminAccess.toString()],
+        ['block_ai_crawlers', siteConfig.blockAiCrawlers.toString()],
+        ['default_license', siteConfig.defaultLicense],
+        ['feed_title', siteConfig.feedTitle],
+        ['stale_after_days', siteConfig.staleAfterDays.toString()],
         ['updated_at', Math.floor(Date.now() / 1000).toString()],
       ];
 
@@ -825,6 +849,32 @@ export default function AdminSettings() {
                               disabled={!isMasterUser}
                             />
                           </div>
+                          <div>
+                            <Label htmlFor="defaultLicense">Default Content License</Label>
+                            <Input
+                              id="defaultLicense"
+                              value={siteConfig.defaultLicense}
+                              onChange={(e) => setSiteConfig(prev => ({ ...prev, defaultLicense: e.target.value }))}
+                              placeholder="CC-BY-4.0"
+                              disabled={!isMasterUser}
+                            />
+                            <p className="text-[10px] text-muted-foreground mt-1">
+                              SPDX identifier applied to new blog posts and shown on posts without their own license.
+                            </p>
+                          </div>
+                          <div>
+                            <Label htmlFor="feedTitle">Feed Title</Label>
+                            <Input
+                              id="feedTitle"
+                              value={siteConfig.feedTitle}
+                              onChange={(e) => setSiteConfig(prev => ({ ...prev, feedTitle: e.target.value }))}
+                              placeholder={siteConfig.title || 'Site title'}
+                              disabled={!isMasterUser}
+                            />
+                            <p className="text-[10px] text-muted-foreground mt-1">
+                              Title of the RSS, Atom and JSON feeds. Per-author feeds append the author's name.
+                            </p>
+                          </div>
                           <div>
                             <Label htmlFor="nip19Gateway">NIP-19 Gateway URL</Label>
                             <Select
@@ -1025,6 +1075,17 @@ export default function AdminSettings() {
                             disabled={!isMasterUser}
                           />
                         </div>
+                        <div className="flex items-center justify-between">
+                          <div>
+                            <Label>Block AI Crawlers</Label>
+                            <p className="text-sm text-muted-foreground">Disallow known AI training crawlers in robots.txt (applied on the next build)</p>
+                          </div>
+                          <Switch
+                            checked={siteConfig.blockAiCrawlers}
+                            onCheckedChange={(checked) => setSiteConfig(prev => ({ ...prev, blockAiCrawlers: checked }))}
+                            disabled={!isMasterUser}
+                          />
+                        </div>
                         <div className="grid grid-cols-1 md:grid-cols-2 gap-4">
                           <div>
                             <Label htmlFor="maxEvents">Maximum Events to Show</Label>
@@ -1050,6 +1111,20 @@ export default function AdminSettings() {
                               disabled={!isMasterUser}
                             />
                           </div>
+                          <div>
+                            <Label htmlFor="staleAfterDays">Review Articles After (days)</Label>
+                            <Input
+                              id="staleAfterDays"
+                              type="number"
+                              value={siteConfig.staleAfterDays}
+                              onChange={(e) => setSiteConfig(prev => ({ ...prev, staleAfterDays: parseInt(e.target.value) || 365 }))}
+                              min="1"
+                              disabled={!isMasterUser}
+                            />
+                            <p className="text-xs text-muted-foreground mt-1">
+                              Published articles not edited for this long get a "Needs review" badge in the blog admin.
+                            </p>
+                          </div>
                         </div>
 
                         <Separator />
@@ -358,6 +358,10 @@ export default function AdminSystemSettings() {
         ['tweakcn_theme_url', siteConfig.tweakcnThemeUrl || ''],
         ['section_order', JSON.stringify(siteConfig.sectionOrder || [])],
         ['read_only_admin_access', siteConfig.readOnlyAdminAccess.toString()],
+        ['block_ai_crawlers', (config.siteConfig?.blockAiCrawlers ?? false).toString()],
+        ['default_license', config.siteConfig?.defaultLicense || ''],
+        ['feed_title', config.siteConfig?.feedTitle || ''],
+        ['stale_after_days', (config.siteConfig?.staleAfterDays ?? 365).toString()],
         ['updated_at', Math.floor(Date.now() / 1000).toString()],
       ];
 
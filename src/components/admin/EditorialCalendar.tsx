@@ -0,0 +1,173 @@
+/**
+ * EditorialCalendar - Month view of scheduled, draft, and published content
+ *
+ * Scheduled entries come from the Swarm scheduler; published posts and
+ * NIP-37 draft wraps are read from the default relay for the visible month.
+ */
+
+import { useMemo, useState } from 'react';
+import { useQuery } from '@tanstack/react-query';
+import {
+  addMonths,
+  eachDayOfInterval,
+  endOfMonth,
+  endOfWeek,
+  format,
+  isSameDay,
+  isSameMonth,
+  startOfMonth,
+  startOfWeek,
+  subMonths,
+} from 'date-fns';
+import { ChevronLeft, ChevronRight, Loader2 } from 'lucide-react';
+import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card';
+import { Button } from '@/components/ui/button';
+import { useDefaultRelay } from '@/hooks/useDefaultRelay';
+import { getLatestRevisions } from '@/lib/latestRevisions';
+import { cn } from '@/lib/utils';
+import type { ScheduledPost } from '@/types/scheduled';
+
+type EntryStatus = 'scheduled' | 'failed' | 'published' | 'draft';
+
+interface CalendarEntry {
+  id: string;
+  title: string;
+  kind: number;
+  status: EntryStatus;
+  date: Date;
+}
+
+const STATUS_STYLES: Record<EntryStatus, string> = {
+  scheduled: 'bg-yellow-500/15 text-yellow-700 dark:text-yellow-300',
+  failed: 'bg-red-500/15 text-red-700 dark:text-red-300',
+  published: 'bg-green-500/15 text-green-700 dark:text-green-300',
+  draft: 'bg-muted text-muted-foreground',
+};
+
+const WEEKDAYS = ['Sun', 'Mon', 'Tue', 'Wed', 'Thu', 'Fri', 'Sat'];
+
+function getEntryTitle(kind: number, tags: string[][], content: string): string {
+  if (kind === 30023) return tags.find(([name]) => name === 'title')?.[1] || 'Untitled';
+  return content.slice(0, 40) || 'Note';
+}
+
+interface EditorialCalendarProps {
+  userPubkey: string;
+  scheduledPosts: ScheduledPost[];
+}
+
+export function EditorialCalendar({ userPubkey, scheduledPosts }: EditorialCalendarProps) {
+  const { nostr } = useDefaultRelay();
+  const [month, setMonth] = useState(() => startOfMonth(new Date()));
+
+  const gridStart = startOfWeek(startOfMonth(month));
+  const gridEnd = endOfWeek(endOfMonth(month));
+  const since = Math.floor(gridStart.getTime() / 1000);
+  const until = Math.floor(gridEnd.getTime() / 1000);
+
+  const { data: relayEntries = [], isLoading } = useQuery({
+    queryKey: ['editorial-calendar', userPubkey, since, until],
+    queryFn: async (): Promise<CalendarEntry[]> => {
+      const events = await nostr!.query([
+        { kinds: [1, 30023], authors: [userPubkey], since, until, limit: 200 },
+        { kinds: [31234], authors: [userPubkey], '#k': ['30023'], since, until, limit: 100 },
+      ], { signal: AbortSignal.timeout(5000) });
+
+      // One entry per article (its latest edit), and no replies: only top-level notes are planned content
+      return getLatestRevisions(events)
+        .filter(event => event.kind !== 30023 || event.tags.find(([name]) => name === 'published')?.[1] !== 'false')
+        .filter(event => event.kind !== 1 || !event.tags.some(([name]) => name === 'e'))
+        .map((event): CalendarEntry => ({
+          id: event.id,
+          // Draft wraps are encrypted, so only the kind is known without decrypting
+          title: event.kind === 31234 ? 'Blog draft' : getEntryTitle(event.kind, event.tags, event.content),
+          kind: event.kind === 31234 ? 30023 : event.kind,
+          status: event.kind === 31234 ? 'draft' : 'published',
+          date: new Date(event.created_at * 1000),
+        }));
+    },
+    enabled: !!nostr && !!userPubkey,
+  });
+
+  const entries = useMemo(() => {
+    const scheduled = scheduledPosts
+      // Published scheduler items are already visible as relay events
+      .filter(post => post.status !== 'published')
+      .map((post): CalendarEntry => ({
+        id: post.id,
+        title: getEntryTitle(post.kind, post.signed_event.tags, post.signed_event.content),
+        kind: post.kind,
+        status: post.status === 'failed' ? 'failed' : 'scheduled',
+        date: new Date(post.scheduled_for),
+      }));
+
+    return [...scheduled, ...relayEntries].sort((a, b) => a.date.getTime() - b.date.getTime());
+  }, [scheduledPosts, relayEntries]);
+
+  const days = eachDayOfInterval({ start: gridStart, end: gridEnd });
+
+  return (
+    <Card>
+      <CardHeader className="flex flex-row items-center justify-between space-y-0">
+        <CardTitle className="text-lg flex items-center gap-2">
+          {format(month, 'MMMM yyyy')}
+          {isLoading && <Loader2 className="h-4 w-4 animate-spin text-muted-foreground" />}
+        </CardTitle>
+        <div className="flex gap-1">
+          <Button variant="outline" size="icon" className="h-8 w-8" onClick={() => setMonth(subMonths(month, 1))} title="Previous month">
+            <ChevronLeft className="h-4 w-4" />
+          </Button>
+          <Button variant="outline" size="sm" className="h-8" onClick={() => setMonth(startOfMonth(new Date()))}>
+            Today
+          </Button>
+          <Button variant="outline" size="icon" className="h-8 w-8" onClick={() => setMonth(addMonths(month, 1))} title="Next month">
+            <ChevronRight className="h-4 w-4" />
+          </Button>
+        </div>
+      </CardHeader>
+      <CardContent className="space-y-3">
+        <div className="grid grid-cols-7 gap-px rounded-md border bg-border overflow-hidden text-xs">
+          {WEEKDAYS.map(day => (
+            <div key={day} className="bg-muted/50 px-2 py-1 font-medium text-muted-foreground">{day}</div>
+          ))}
+          {days.map(day => {
+            const dayEntries = entries.filter(entry => isSameDay(entry.date, day));
+            return (
+              <div
+                key={day.toISOString()}
+                className={cn(
+                  'bg-background min-h-24 p-1 space-y-1',
+                  !isSameMonth(day, month) && 'bg-muted/30 text-muted-foreground',
+                )}
+              >
+                <div className={cn('px-1', isSameDay(day, new Date()) && 'font-bold text-primary')}>
+                  {format(day, 'd')}
+                </div>
+                {dayEntries.slice(0, 3).map(entry => (
+                  <div
+                    key={entry.id}
+                    className={cn('truncate rounded px-1 py-0.5', STATUS_STYLES[entry.status])}
+                    title={`${entry.title} · ${entry.status} · ${format(entry.date, 'h:mm a')}`}
+                  >
+                    {entry.title}
+                  </div>
+                ))}
+                {dayEntries.length > 3 && (
+                  <div className="px-1 text-muted-foreground">+{dayEntries.length - 3} more</div>
+                )}
+              </div>
+            );
+          })}
+        </div>
+        <div className="flex flex-wrap gap-3 text-xs text-muted-foreground">
+          {(Object.keys(STATUS_STYLES) as EntryStatus[]).map(status => (
+            <span key={status} className="flex items-center gap-1">
+              <span className={cn('h-3 w-3 rounded', STATUS_STYLES[status])} />
+              {status}
+            </span>
+          ))}
+        </div>
+      </CardContent>
+    </Card>
+  );
+}
@@ -54,6 +54,14 @@ export interface AppConfig {
     zaplyticsSectionOrder?: string[];
     /** Whether admin settings are read-only for non-master admins */
     readOnlyAdminAccess?: boolean;
+    /** Whether the generated robots.txt disallows known AI training crawlers */
+    blockAiCrawlers?: boolean;
+    /** License applied to new articles and shown on articles without one (SPDX id) */
+    defaultLicense?: string;
+    /** Title of the generated RSS, Atom and JSON feeds (defaults to the site title) */
+    feedTitle?: string;
+    /** Days without an edit after which a published article is marked for review (defaults to 365) */
+    staleAfterDays?: number;
     /** Last time the site config was updated from/to Nostr */
     updatedAt?: number;
   };
@@ -73,6 +81,10 @@ export interface AppContextType {
   config: AppConfig;
   /** Update configuration using a callback that receives current config and returns new config */
   updateConfig: (updater: (currentConfig: Partial<AppConfig>) => Partial<AppConfig>) => void;
+  /** Whether NostrSync has finished its first site config (kind 30078) fetch this session */
+  siteConfigSynced: boolean;
+  /** Called by NostrSync once the site config fetch has completed or failed */
+  setSiteConfigSynced: (synced: boolean) => void;
 }
 
 export const AppContext = createContext<AppContextType | undefined>(undefined);
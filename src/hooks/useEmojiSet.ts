@@ -0,0 +1,72 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import type { NStore } from '@nostrify/nostrify';
+import { useDefaultRelay } from '@/hooks/useDefaultRelay';
+import { useNostrPublish } from '@/hooks/useNostrPublish';
+import { getEmojiSetDTag, getMasterPubkey } from '@/lib/relay';
+import { EMOJI_SET_KIND, EMPTY_EMOJI_SET, parseEmojiSet, type EmojiSet } from '@/lib/emoji';
+
+async function fetchEmojiSet(nostr: NStore, masterPubkey: string): Promise<EmojiSet> {
+  const events = await nostr.query(
+    [{ kinds: [EMOJI_SET_KIND], authors: [masterPubkey], '#d': [getEmojiSetDTag()], limit: 1 }],
+    { signal: AbortSignal.timeout(5000) }
+  );
+
+  const latest = [...events].sort((a, b) => b.created_at - a.created_at)[0];
+  return parseEmojiSet(latest);
+}
+
+/**
+ * The site's custom emoji set published by the master user.
+ * Reads from the default relay only, like the rest of the CMS content.
+ */
+export function useEmojiSet() {
+  const { nostr } = useDefaultRelay();
+  const masterPubkey = getMasterPubkey();
+  const dTag = getEmojiSetDTag();
+
+  return useQuery({
+    queryKey: ['emoji-set', masterPubkey, dTag],
+    queryFn: async (): Promise<EmojiSet> => {
+      if (!masterPubkey) return EMPTY_EMOJI_SET;
+      return fetchEmojiSet(nostr!, masterPubkey);
+    },
+    enabled: !!nostr,
+    staleTime: 60 * 1000,
+  });
+}
+
+/**
+ * Replace the emoji set. Like the featured list, updates are applied to the
+ * latest set on the relay and run one at a time.
+ */
+export function useUpdateEmojiSet() {
+  const queryClient = useQueryClient();
+  const { nostr } = useDefaultRelay();
+  const { mutateAsync: publishEvent } = useNostrPublish();
+
+  return useMutation({
+    scope: { id: 'emoji-set' },
+    mutationFn: async (update: (set: EmojiSet) => EmojiSet['emojis']) => {
+      const masterPubkey = getMasterPubkey();
+      if (!nostr || !masterPubkey) throw new Error('No relay or master user configured');
+
+      const current = await fetchEmojiSet(nostr, masterPubkey);
+      const event = await publishEvent({
+        event: {
+          kind: EMOJI_SET_KIND,
+          content: '',
+          tags: [
+            ['d', getEmojiSetDTag()],
+            ['title', 'Site emoji'],
+            ...update(current).map(({ shortcode, url }) => ['emoji', shortcode, url]),
+          ],
+          created_at: Math.max(Math.floor(Date.now() / 1000), current.updatedAt + 1),
+        },
+      });
+      return parseEmojiSet(event);
+    },
+    onSuccess: (set) => {
+      queryClient.setQueryData(['emoji-set', getMasterPubkey(), getEmojiSetDTag()], set);
+    },
+  });
+}
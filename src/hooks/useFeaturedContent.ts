@@ -0,0 +1,76 @@
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query';
+import type { NStore } from '@nostrify/nostrify';
+import { useDefaultRelay } from '@/hooks/useDefaultRelay';
+import { useNostrPublish } from '@/hooks/useNostrPublish';
+import { getFeaturedListDTag, getMasterPubkey } from '@/lib/relay';
+import { EMPTY_FEATURED_LIST, FEATURED_LIST_KIND, parseFeaturedList, type FeaturedList } from '@/lib/featured';
+
+async function fetchFeaturedList(nostr: NStore, masterPubkey: string): Promise<FeaturedList> {
+  const events = await nostr.query(
+    [{ kinds: [FEATURED_LIST_KIND], authors: [masterPubkey], '#d': [getFeaturedListDTag()], limit: 1 }],
+    { signal: AbortSignal.timeout(5000) }
+  );
+
+  const latest = [...events].sort((a, b) => b.created_at - a.created_at)[0];
+  return parseFeaturedList(latest);
+}
+
+/**
+ * Front-page curation list published by the master user.
+ * Reads from the default relay only, like the rest of the CMS content.
+ */
+export function useFeaturedContent() {
+  const { nostr } = useDefaultRelay();
+  const masterPubkey = getMasterPubkey();
+  const dTag = getFeaturedListDTag();
+
+  return useQuery({
+    queryKey: ['featured-content', masterPubkey, dTag],
+    queryFn: async (): Promise<FeaturedList> => {
+      if (!masterPubkey) return EMPTY_FEATURED_LIST;
+      return fetchFeaturedList(nostr!, masterPubkey);
+    },
+    enabled: !!nostr,
+    staleTime: 60 * 1000,
+  });
+}
+
+/**
+ * Replace the curation list with a new ordered set of article coordinates.
+ *
+ * The update is applied to the latest list on the relay rather than the cached
+ * one, and updates share a mutation scope so they run one at a time. Quick
+ * successive pins therefore build on each other instead of overwriting.
+ */
+export function useUpdateFeaturedContent() {
+  const queryClient = useQueryClient();
+  const { nostr } = useDefaultRelay();
+  const { mutateAsync: publishEvent } = useNostrPublish();
+
+  return useMutation({
+    scope: { id: 'featured-content' },
+    mutationFn: async (update: (list: FeaturedList) => FeaturedList['items']) => {
+      const masterPubkey = getMasterPubkey();
+      if (!nostr || !masterPubkey) throw new Error('No relay or master user configured');
+
+      const current = await fetchFeaturedList(nostr, masterPubkey);
+      const event = await publishEvent({
+        event: {
+          kind: FEATURED_LIST_KIND,
+          content: '',
+          tags: [
+            ['d', getFeaturedListDTag()],
+            ['title', 'Featured'],
+            ...update(current).map((coordinate) => ['a', coordinate]),
+          ],
+          // A replacement in the same second could lose the tie-break on the relay
+          created_at: Math.max(Math.floor(Date.now() / 1000), current.updatedAt + 1),
+        },
+      });
+      return parseFeaturedList(event);
+    },
+    onSuccess: (list) => {
+      queryClient.setQueryData(['featured-content', getMasterPubkey(), getFeaturedListDTag()], list);
+    },
+  });
+}
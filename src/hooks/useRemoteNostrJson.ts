@@ -8,17 +8,49 @@ interface NostrJsonResponse {
 }
 
 const DEFAULT_NOSTR_JSON_URL = import.meta.env.VITE_REMOTE_NOSTR_JSON_URL || '/.well-known/nostr.json';
+const CACHE_KEY_PREFIX = 'nostr-json-cache:';
+
+/** Domain the team list is served from, i.e. the domain part of members' NIP-05 handles. */
+export function getNostrJsonDomain(url: string = DEFAULT_NOSTR_JSON_URL): string {
+  try {
+    return new URL(url, window.location.origin).hostname;
+  } catch {
+    return window.location.hostname;
+  }
+}
+
+function readCachedNostrJson(url: string): NostrJsonResponse | null {
+  try {
+    const cached = localStorage.getItem(CACHE_KEY_PREFIX + url);
+    return cached ? JSON.parse(cached) : null;
+  } catch {
+    return null;
+  }
+}
 
 export function useRemoteNostrJson(url: string = DEFAULT_NOSTR_JSON_URL) {
   return useQuery({
     queryKey: ['remote-nostr-json', url],
     queryFn: async () => {
       if (!url) return null;
-      const response = await fetch(url);
-      if (!response.ok) {
-        throw new Error('Failed to fetch nostr.json');
+      let data: NostrJsonResponse;
+      try {
+        const response = await fetch(url);
+        if (!response.ok) {
+          throw new Error('Failed to fetch nostr.json');
+        }
+        data = await response.json();
+      } catch (error) {
+        // Keep the last good team list during remote-domain downtime
+        const cached = readCachedNostrJson(url);
+        if (cached) return cached;
+        throw error;
+      }
+      try {
+        localStorage.setItem(CACHE_KEY_PREFIX + url, JSON.stringify(data));
+      } catch {
+        // Quota or private mode: the fresh list is still good, it just isn't cached
       }
-      const data: NostrJsonResponse = await response.json();
       return data;
     },
     staleTime: 5 * 60 * 1000, // 5 minutes
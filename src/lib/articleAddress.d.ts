@@ -0,0 +1,2 @@
+export function normalizeRelayHint(url: string): string;
+export function encodeArticleNaddr(pubkey: string, identifier: string, relay?: string): string;
@@ -0,0 +1,32 @@
+import { naddrEncode } from 'nostr-tools/nip19';
+
+/**
+ * Article naddr encoding, kept in plain JavaScript so the build script and
+ * the app produce byte-identical identifiers. The build writes pages under
+ * `/<naddr>` and `/r/<naddr>`, so any difference (e.g. a trailing slash in the
+ * relay hint) would miss them.
+ */
+
+/**
+ * @param {string} url
+ * @returns {string}
+ */
+export function normalizeRelayHint(url) {
+  return url.trim().replace(/\/+$/, '');
+}
+
+/**
+ * @param {string} pubkey
+ * @param {string} identifier
+ * @param {string} [relay]
+ * @returns {string}
+ */
+export function encodeArticleNaddr(pubkey, identifier, relay) {
+  const hint = relay ? normalizeRelayHint(relay) : '';
+  return naddrEncode({
+    kind: 30023,
+    pubkey,
+    identifier,
+    relays: hint ? [hint] : [],
+  });
+}
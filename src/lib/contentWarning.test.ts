@@ -0,0 +1,28 @@
+import { describe, expect, it } from 'vitest';
+import { getContentWarning, hasSensitiveOptIn, isSensitive } from './contentWarning';
+
+describe('getContentWarning', () => {
+  it('returns the NIP-36 reason', () => {
+    expect(getContentWarning({ tags: [['content-warning', 'nudity']] })).toBe('nudity');
+  });
+
+  it('returns an empty string when no reason is given', () => {
+    expect(getContentWarning({ tags: [['content-warning']] })).toBe('');
+    expect(getContentWarning({ tags: [['t', 'NSFW']] })).toBe('');
+    expect(getContentWarning({ tags: [['L', 'content-warning'], ['l', 'nsfw', 'content-warning']] })).toBe('');
+  });
+
+  it('ignores unflagged events', () => {
+    expect(getContentWarning({ tags: [['t', 'bitcoin']] })).toBeUndefined();
+    expect(isSensitive({ tags: [] })).toBe(false);
+  });
+});
+
+describe('hasSensitiveOptIn', () => {
+  it('requires an explicit truthy value', () => {
+    expect(hasSensitiveOptIn(new URLSearchParams('nsfw=1'))).toBe(true);
+    expect(hasSensitiveOptIn(new URLSearchParams('nsfw=true'))).toBe(true);
+    expect(hasSensitiveOptIn(new URLSearchParams('nsfw=0'))).toBe(false);
+    expect(hasSensitiveOptIn(new URLSearchParams(''))).toBe(false);
+  });
+});
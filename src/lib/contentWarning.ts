@@ -0,0 +1,30 @@
+import type { NostrEvent } from '@nostrify/nostrify';
+import { getContentWarningFromTags } from './contentWarningTags';
+
+/**
+ * NIP-36 sensitive content helpers.
+ *
+ * An event is flagged when it carries a `content-warning` tag (the reason is
+ * optional), a NIP-32 `nsfw` label, or the conventional `#nsfw` hashtag.
+ */
+
+/** Query parameter that opts a public listing into flagged content. */
+export const SENSITIVE_OPT_IN_PARAM = 'nsfw';
+
+/**
+ * Returns the warning reason for a flagged event, an empty string when it is
+ * flagged without a reason, or `undefined` when it is not flagged.
+ */
+export function getContentWarning(event: Pick<NostrEvent, 'tags'>): string | undefined {
+  return getContentWarningFromTags(event.tags);
+}
+
+export function isSensitive(event: Pick<NostrEvent, 'tags'>): boolean {
+  return getContentWarning(event) !== undefined;
+}
+
+/** True when the current URL explicitly asks for flagged content, e.g. `?nsfw=1`. */
+export function hasSensitiveOptIn(searchParams: URLSearchParams): boolean {
+  const value = searchParams.get(SENSITIVE_OPT_IN_PARAM);
+  return value === '1' || value === 'true';
+}
@@ -0,0 +1 @@
+export function getContentWarningFromTags(tags: string[][]): string | undefined;
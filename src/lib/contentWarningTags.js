@@ -0,0 +1,20 @@
+/**
+ * NIP-36 tag rules, kept in plain JavaScript so the build script
+ * (`scripts/generate-route-meta.mjs`) can share them with the app.
+ *
+ * Returns the warning reason, an empty string when the event is flagged
+ * without a reason, or `undefined` when it is not flagged.
+ *
+ * @param {string[][]} tags
+ * @returns {string | undefined}
+ */
+export function getContentWarningFromTags(tags) {
+  const warning = tags.find(([name]) => name === 'content-warning');
+  if (warning) return warning[1]?.trim() || '';
+
+  const labelled = tags.some(([name, value]) =>
+    (name === 'l' && value?.toLowerCase() === 'nsfw') ||
+    (name === 't' && value?.toLowerCase() === 'nsfw')
+  );
+  return labelled ? '' : undefined;
+}
@@ -0,0 +1,41 @@
+import { describe, expect, it } from 'vitest';
+import type { NostrEvent } from '@nostrify/nostrify';
+import { getEmojiTags, isValidShortcode, parseEmojiSet } from './emoji';
+
+describe('emoji helpers', () => {
+  it('reads valid emoji tags and drops duplicates', () => {
+    const event = {
+      kind: 30030,
+      created_at: 7,
+      tags: [
+        ['d', 'emoji'],
+        ['emoji', 'soapbox', 'https://example.com/soapbox.png'],
+        ['emoji', 'soapbox', 'https://example.com/other.png'],
+        ['emoji', 'bad code', 'https://example.com/bad.png'],
+        ['emoji', 'script', 'javascript:alert(1)'],
+      ],
+    } as NostrEvent;
+
+    expect(parseEmojiSet(event)).toEqual({
+      emojis: [{ shortcode: 'soapbox', url: 'https://example.com/soapbox.png' }],
+      updatedAt: 7,
+    });
+  });
+
+  it('tags only the shortcodes used in the content', () => {
+    const emojis = [
+      { shortcode: 'soapbox', url: 'https://example.com/soapbox.png' },
+      { shortcode: 'nostr', url: 'https://example.com/nostr.png' },
+    ];
+
+    expect(getEmojiTags('Hello :soapbox: see:nostr:npub1', emojis)).toEqual([
+      ['emoji', 'soapbox', 'https://example.com/soapbox.png'],
+    ]);
+  });
+
+  it('validates shortcodes', () => {
+    expect(isValidShortcode('party_parrot-2')).toBe(true);
+    expect(isValidShortcode('no spaces')).toBe(false);
+    expect(isValidShortcode('')).toBe(false);
+  });
+});
@@ -0,0 +1,53 @@
+import type { NostrEvent } from '@nostrify/nostrify';
+
+/**
+ * Site custom emoji helpers.
+ *
+ * The site's emoji are a NIP-51 emoji set (kind 30030) published by the master
+ * pubkey, one `["emoji", shortcode, url]` tag per image. Notes that use them
+ * carry the matching NIP-30 `emoji` tags so other clients can render them.
+ */
+
+export const EMOJI_SET_KIND = 30030;
+
+export interface CustomEmoji {
+  shortcode: string;
+  url: string;
+}
+
+export interface EmojiSet {
+  emojis: CustomEmoji[];
+  /** Unix timestamp of the set event, 0 when no set has been published */
+  updatedAt: number;
+}
+
+export const EMPTY_EMOJI_SET: EmojiSet = { emojis: [], updatedAt: 0 };
+
+/** NIP-30 shortcodes are alphanumeric characters, hyphens and underscores. */
+export function isValidShortcode(shortcode: string): boolean {
+  return /^[a-zA-Z0-9_-]+$/.test(shortcode);
+}
+
+export function parseEmojiSet(event: NostrEvent | undefined): EmojiSet {
+  if (!event) return EMPTY_EMOJI_SET;
+
+  const seen = new Set<string>();
+  const emojis: CustomEmoji[] = [];
+  for (const [name, shortcode, url] of event.tags) {
+    if (name !== 'emoji' || !shortcode || !isValidShortcode(shortcode) || seen.has(shortcode)) continue;
+    if (!/^https?:\/\//i.test(url ?? '')) continue;
+
+    seen.add(shortcode);
+    emojis.push({ shortcode, url });
+  }
+
+  return { emojis, updatedAt: event.created_at };
+}
+
+/** `emoji` tags for the set's shortcodes that appear in `content`. */
+export function getEmojiTags(content: string, emojis: CustomEmoji[]): string[][] {
+  const used = new Set([...content.matchAll(/(?<!\w):([a-zA-Z0-9_-]+):/g)].map(([, shortcode]) => shortcode));
+  return emojis
+    .filter(({ shortcode }) => used.has(shortcode))
+    .map(({ shortcode, url }) => ['emoji', shortcode, url]);
+}
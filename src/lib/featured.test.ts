@@ -0,0 +1,70 @@
+import { describe, expect, it } from 'vitest';
+import type { NostrEvent } from '@nostrify/nostrify';
+import { getArticleCoordinate, moveFeatured, parseFeaturedList, sortByFeatured, toggleFeatured, type FeaturedList } from './featured';
+import { getLatestRevisions } from './latestRevisions';
+
+const pubkey = 'e4690a13290739da123aa17d553851dec4cdd0e9d89aa18de3741c446caf8761';
+
+function post(d: string) {
+  return { pubkey, d };
+}
+
+describe('featured helpers', () => {
+  it('puts featured posts first in curation order', () => {
+    const list: FeaturedList = {
+      items: [getArticleCoordinate(pubkey, 'c'), getArticleCoordinate(pubkey, 'a')],
+      updatedAt: 1,
+    };
+
+    const sorted = sortByFeatured([post('a'), post('b'), post('c'), post('d')], list);
+
+    expect(sorted.map((p) => p.d)).toEqual(['c', 'a', 'b', 'd']);
+  });
+
+  it('features a pinned article once when the relay returns several revisions', () => {
+    const list: FeaturedList = { items: [getArticleCoordinate(pubkey, 'a')], updatedAt: 1 };
+    const events = [
+      { id: 'b1', pubkey, created_at: 3, tags: [['d', 'b']] },
+      { id: 'a2', pubkey, created_at: 2, tags: [['d', 'a']] },
+      { id: 'a1', pubkey, created_at: 1, tags: [['d', 'a']] },
+    ];
+
+    const posts = getLatestRevisions(events).map((event) => ({ id: event.id, pubkey, d: event.tags[0][1] }));
+    const sorted = sortByFeatured(posts, list);
+
+    expect(sorted.map((p) => p.id)).toEqual(['a2', 'b1']);
+  });
+
+  it('leaves order untouched without featured articles', () => {
+    const posts = [post('a'), post('b')];
+    expect(sortByFeatured(posts, { items: [], updatedAt: 1 })).toBe(posts);
+  });
+
+  it('reads article references and ignores notes', () => {
+    const event = {
+      kind: 30004,
+      created_at: 5,
+      tags: [
+        ['d', 'featured'],
+        ['e', 'abc'],
+        ['a', getArticleCoordinate(pubkey, 'a')],
+        ['a', ''],
+      ],
+    } as NostrEvent;
+
+    expect(parseFeaturedList(event)).toEqual({ items: [getArticleCoordinate(pubkey, 'a')], updatedAt: 5 });
+  });
+
+  it('toggles and reorders references', () => {
+    const ref = getArticleCoordinate(pubkey, 'a');
+    const other = getArticleCoordinate(pubkey, 'b');
+
+    const added = toggleFeatured({ items: [other], updatedAt: 1 }, ref);
+    expect(added).toEqual([other, ref]);
+
+    expect(moveFeatured({ items: added, updatedAt: 1 }, ref, -1)).toEqual([ref, other]);
+    expect(moveFeatured({ items: added, updatedAt: 1 }, other, -1)).toEqual(added);
+
+    expect(toggleFeatured({ items: added, updatedAt: 1 }, ref)).toEqual([other]);
+  });
+});
@@ -0,0 +1,94 @@
+import type { NostrEvent, NostrFilter } from '@nostrify/nostrify';
+
+/**
+ * Front-page curation helpers.
+ *
+ * Featured content is stored as a NIP-51 curation set (kind 30004) published by
+ * the master pubkey. Tag order is the display order, and each `a` tag points at a
+ * kind 30023 article. Only articles can be pinned; `e` tags (notes) are ignored.
+ */
+
+export const FEATURED_LIST_KIND = 30004;
+
+export interface FeaturedList {
+  /** Ordered `kind:pubkey:d` coordinates of the pinned articles */
+  items: string[];
+  /** Unix timestamp of the list event, 0 when no list has been published */
+  updatedAt: number;
+}
+
+export const EMPTY_FEATURED_LIST: FeaturedList = { items: [], updatedAt: 0 };
+
+/** Build the `kind:pubkey:d` coordinate for a long-form article. */
+export function getArticleCoordinate(pubkey: string, d: string): string {
+  return `30023:${pubkey.toLowerCase().trim()}:${d}`;
+}
+
+export function parseFeaturedList(event: NostrEvent | undefined): FeaturedList {
+  if (!event) return EMPTY_FEATURED_LIST;
+
+  const items = event.tags
+    .filter(([name, value]) => name === 'a' && !!value)
+    .map(([, value]) => value);
+
+  return { items, updatedAt: event.created_at };
+}
+
+/**
+ * Filters that fetch every featured article directly, so pinned posts older
+ * than the regular "latest posts" window still reach the front page.
+ */
+export function getFeaturedArticleFilters(list: FeaturedList): NostrFilter[] {
+  return list.items
+    .map((coordinate) => coordinate.split(':'))
+    .filter(([kind, pubkey]) => kind === '30023' && /^[0-9a-f]{64}$/.test(pubkey))
+    .map(([, pubkey, ...rest]) => ({ kinds: [30023], authors: [pubkey], '#d': [rest.join(':')], limit: 1 }));
+}
+
+export function isFeatured(list: FeaturedList, coordinate: string): boolean {
+  return list.items.includes(coordinate);
+}
+
+export function toggleFeatured(list: FeaturedList, coordinate: string): FeaturedList['items'] {
+  if (isFeatured(list, coordinate)) {
+    return list.items.filter((value) => value !== coordinate);
+  }
+  return [...list.items, coordinate];
+}
+
+export function moveFeatured(list: FeaturedList, coordinate: string, offset: -1 | 1): FeaturedList['items'] {
+  const items = [...list.items];
+  const index = items.indexOf(coordinate);
+  const target = index + offset;
+  if (index === -1 || target < 0 || target >= items.length) return items;
+
+  [items[index], items[target]] = [items[target], items[index]];
+  return items;
+}
+
+/**
+ * Order articles so featured ones come first (in curation order), followed by
+ * the rest in their existing order.
+ */
+export function sortByFeatured<T extends { pubkey: string; d: string }>(posts: T[], list: FeaturedList): T[] {
+  const rank = new Map<string, number>();
+  list.items.forEach((coordinate, index) => rank.set(coordinate, index));
+
+  if (rank.size === 0) return posts;
+
+  const featured: T[] = [];
+  const rest: T[] = [];
+  for (const post of posts) {
+    if (rank.has(getArticleCoordinate(post.pubkey, post.d))) {
+      featured.push(post);
+    } else {
+      rest.push(post);
+    }
+  }
+
+  featured.sort((a, b) =>
+    rank.get(getArticleCoordinate(a.pubkey, a.d))! - rank.get(getArticleCoordinate(b.pubkey, b.d))!
+  );
+
+  return [...featured, ...rest];
+}
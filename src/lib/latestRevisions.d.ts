@@ -0,0 +1 @@
+export function getLatestRevisions<T extends { id: string; pubkey: string; created_at: number; tags: string[][] }>(events: T[]): T[];
@@ -0,0 +1,36 @@
+/**
+ * Addressable-event revision rules, kept in plain JavaScript so the build
+ * script (`scripts/generate-route-meta.mjs`) can share them with the app.
+ *
+ * Relays may return several revisions of an edited article. Keeps the newest
+ * event per `pubkey:d` coordinate, breaking created_at ties by the lowest id
+ * as NIP-01 does. Events without a `d` tag are only deduped by id. Input order
+ * is otherwise preserved.
+ *
+ * @template {{ id: string, pubkey: string, created_at: number, tags: string[][] }} T
+ * @param {T[]} events
+ * @returns {T[]}
+ */
+export function getLatestRevisions(events) {
+  const latest = new Map();
+  for (const event of events) {
+    const d = event.tags.find(([name]) => name === 'd')?.[1];
+    const key = d !== undefined ? `${event.pubkey}:${d}` : event.id;
+    const current = latest.get(key);
+    if (
+      !current ||
+      event.created_at > current.created_at ||
+      (event.created_at === current.created_at && event.id < current.id)
+    ) {
+      latest.set(key, event);
+    }
+  }
+
+  const kept = new Set(latest.values());
+  const result = [];
+  for (const event of events) {
+    // The same event object can appear twice when a query has several filters
+    if (kept.delete(event)) result.push(event);
+  }
+  return result;
+}
@@ -0,0 +1,22 @@
+import { describe, expect, it } from 'vitest';
+import { getLatestRevisions } from './latestRevisions';
+
+function revision(id: string, created_at: number, d?: string) {
+  return { id, pubkey: 'p', created_at, tags: d === undefined ? [] : [['d', d]] };
+}
+
+describe('getLatestRevisions', () => {
+  it('keeps the newest revision per coordinate in input order', () => {
+    const events = [revision('old', 1, 'a'), revision('other', 3, 'b'), revision('new', 2, 'a')];
+    expect(getLatestRevisions(events).map((e) => e.id)).toEqual(['other', 'new']);
+  });
+
+  it('breaks created_at ties by the lowest id', () => {
+    expect(getLatestRevisions([revision('b', 1, 'a'), revision('a', 1, 'a')]).map((e) => e.id)).toEqual(['a']);
+  });
+
+  it('dedupes events without a d tag by id', () => {
+    const note = revision('n', 1);
+    expect(getLatestRevisions([note, note, revision('m', 1)]).map((e) => e.id)).toEqual(['n', 'm']);
+  });
+});
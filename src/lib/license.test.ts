@@ -0,0 +1,20 @@
+import { describe, expect, it } from 'vitest';
+import { getLicense, getLicenseTags, getLicenseUrl } from './license';
+
+describe('license labels', () => {
+  it('round-trips through NIP-32 tags', () => {
+    const tags = getLicenseTags(' CC-BY-4.0 ');
+    expect(tags).toEqual([['L', 'license'], ['l', 'CC-BY-4.0', 'license']]);
+    expect(getLicense([['d', 'post'], ...tags])).toBe('CC-BY-4.0');
+  });
+
+  it('ignores labels in other namespaces', () => {
+    expect(getLicense([['l', 'nsfw', 'content-warning']])).toBeUndefined();
+    expect(getLicenseTags('')).toEqual([]);
+  });
+
+  it('links SPDX identifiers only', () => {
+    expect(getLicenseUrl('CC-BY-NC-4.0')).toBe('https://spdx.org/licenses/CC-BY-NC-4.0.html');
+    expect(getLicenseUrl('All rights reserved')).toBeUndefined();
+  });
+});
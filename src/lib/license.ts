@@ -0,0 +1,25 @@
+/**
+ * Content license helpers.
+ *
+ * Articles carry their license as a NIP-32 self-label in the `license`
+ * namespace, e.g. `["L", "license"], ["l", "CC-BY-4.0", "license"]`, using SPDX
+ * identifiers where possible.
+ */
+
+export const LICENSE_NAMESPACE = 'license';
+
+export function getLicense(tags: string[][]): string | undefined {
+  return tags.find(([name, , namespace]) => name === 'l' && namespace === LICENSE_NAMESPACE)?.[1] || undefined;
+}
+
+export function getLicenseTags(license: string | undefined): string[][] {
+  const value = license?.trim();
+  if (!value) return [];
+  return [['L', LICENSE_NAMESPACE], ['l', value, LICENSE_NAMESPACE]];
+}
+
+/** Human-readable page for an SPDX identifier, or undefined for free-form licenses. */
+export function getLicenseUrl(license: string): string | undefined {
+  if (!/^[A-Za-z0-9.+-]+$/.test(license)) return undefined;
+  return `https://spdx.org/licenses/${license}.html`;
+}
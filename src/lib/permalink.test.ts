@@ -0,0 +1,26 @@
+import { nip19 } from 'nostr-tools';
+import { describe, expect, it } from 'vitest';
+import { encodeArticleNaddr } from './articleAddress';
+import { getArticleNaddr, getArticlePermalink } from './permalink';
+import { getDefaultRelayUrl } from './relay';
+
+const pubkey = '79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798';
+
+describe('article permalinks', () => {
+  it('encodes the article address with a relay hint', () => {
+    const decoded = nip19.decode(getArticleNaddr(pubkey, 'my-post'));
+    expect(decoded).toEqual({
+      type: 'naddr',
+      data: { kind: 30023, pubkey, identifier: 'my-post', relays: [getDefaultRelayUrl().replace(/\/+$/, '')] },
+    });
+  });
+
+  it('ignores trailing slashes in the relay hint', () => {
+    expect(encodeArticleNaddr(pubkey, 'my-post', 'wss://relay.example/'))
+      .toBe(encodeArticleNaddr(pubkey, 'my-post', 'wss://relay.example'));
+  });
+
+  it('builds a site URL for the naddr route', () => {
+    expect(getArticlePermalink(pubkey, 'my-post')).toBe(`${window.location.origin}/${getArticleNaddr(pubkey, 'my-post')}`);
+  });
+});
@@ -0,0 +1,15 @@
+import { encodeArticleNaddr } from './articleAddress';
+import { getDefaultRelayUrl } from './relay';
+
+/**
+ * Stable NIP-19 address for a long-form article. Unlike the event id used in
+ * `/blog/<id>`, an naddr keeps pointing at the latest version after edits.
+ */
+export function getArticleNaddr(pubkey: string, identifier: string): string {
+  return encodeArticleNaddr(pubkey, identifier, getDefaultRelayUrl());
+}
+
+/** Site URL for an article's naddr; the NIP-19 route forwards it to the post. */
+export function getArticlePermalink(pubkey: string, identifier: string): string {
+  return `${window.location.origin}/${getArticleNaddr(pubkey, identifier)}`;
+}
@@ -92,6 +92,18 @@ export function getSiteConfigDTag(): string {
 /** The legacy unscoped d-tag, used as migration fallback. */
 export const LEGACY_SITE_CONFIG_DTAG = 'nostr-meetup-site-config';
 
+/** Relay-scoped d-tag for the front-page curation set (Kind 30004). */
+export function getFeaturedListDTag(): string {
+  const relay = getDefaultRelayUrl();
+  return `nostr-meetup-featured:${relay}`;
+}
+
+/** Relay-scoped d-tag for the site's custom emoji set (Kind 30030). */
+export function getEmojiSetDTag(): string {
+  const relay = getDefaultRelayUrl();
+  return `nostr-meetup-emoji:${relay}`;
+}
+
 export function getApiBaseUrl(): string {
   const envSwarmApi = import.meta.env.VITE_SWARM_API_URL;
   if (envSwarmApi) return envSwarmApi;
@@ -0,0 +1,70 @@
+/** An article as the build script sees it: the latest published revision. */
+export interface StaticArticle {
+  id: string;
+  pubkey: string;
+  d: string;
+  title: string;
+  content: string;
+  summary: string;
+  image: string;
+  contentWarning: string | undefined;
+  sensitive: boolean;
+  license: string;
+  /** Unix seconds */
+  createdAt: number;
+}
+
+export interface ArchivePeriod {
+  year: number;
+  /** 1-12 */
+  month: number;
+  count: number;
+}
+
+export interface AuthorPage {
+  name: string;
+  pubkey: string;
+  count: number;
+  lastmod: number | undefined;
+}
+
+export interface FeedItem {
+  id: string;
+  url: string;
+  title: string;
+  author: string;
+  summary: string;
+  html: string;
+  license: string;
+  date: Date;
+}
+
+export interface Feed {
+  title: string;
+  description: string;
+  homeUrl: string;
+  feedUrl: string;
+  license: string;
+  items: FeedItem[];
+}
+
+export interface ManifestIcon {
+  src: string;
+  type?: string;
+  sizes?: string;
+}
+
+export function getTagValue(tags: string[][], name: string): string;
+export function getLatestArticles(
+  postEvents: Array<{ id: string; pubkey: string; created_at: number; tags: string[][]; content: string }>,
+  defaultLicense: string,
+): StaticArticle[];
+export function escapeHtml(value: string): string;
+export function getArchivePeriods(blogPosts: StaticArticle[]): ArchivePeriod[];
+export function getFeedArticles(blogPosts: StaticArticle[]): StaticArticle[];
+export function getAuthorPages(blogPosts: StaticArticle[], teamNames: Record<string, string>): AuthorPage[];
+export function buildRssXml(feed: Feed): string;
+export function buildAtomXml(feed: Feed): string;
+export function buildJsonFeed(feed: Feed): string;
+export function toManifestIcon(src: string): ManifestIcon;
+export function toShortName(title: string): string;
@@ -0,0 +1,226 @@
+/**
+ * Pure builders for the static files written by
+ * `scripts/generate-route-meta.mjs` (feeds, archive index, manifest), kept in
+ * plain JavaScript so they can be unit tested without running the build.
+ *
+ * Posts here are the build's article records, see `StaticArticle` in
+ * `staticSite.d.ts`.
+ */
+
+import { getContentWarningFromTags } from './contentWarningTags.js';
+import { getLatestRevisions } from './latestRevisions.js';
+
+export function getTagValue(tags, name) {
+  return tags.find(([tagName]) => tagName === name)?.[1] || '';
+}
+
+/** `image` tag, falling back to the first NIP-92 imeta attachment */
+function getImage(tags) {
+  const image = getTagValue(tags, 'image');
+  if (image) return image;
+
+  const imeta = tags.find(([name]) => name === 'imeta') || [];
+  const url = imeta.slice(1).find((entry) => entry.startsWith('url '));
+  return url ? url.slice(4).trim() : '';
+}
+
+function getLicenseLabel(tags) {
+  return tags.find(([name, , namespace]) => name === 'l' && namespace === 'license')?.[1] || '';
+}
+
+/**
+ * Latest revision of each published article, newest first. Revisions are
+ * collapsed before the `published` check, so an article whose newest
+ * revision is unpublished drops out instead of falling back to an older one.
+ */
+export function getLatestArticles(postEvents, defaultLicense) {
+  return getLatestRevisions(postEvents)
+    .filter((event) => getTagValue(event.tags, 'published') !== 'false')
+    .map((event) => {
+      const tags = event.tags;
+      return {
+        id: event.id,
+        pubkey: event.pubkey,
+        d: getTagValue(tags, 'd'),
+        title: getTagValue(tags, 'title') || 'Untitled',
+        content: event.content || '',
+        summary: getTagValue(tags, 'summary'),
+        image: getImage(tags),
+        contentWarning: getContentWarningFromTags(tags),
+        sensitive: getContentWarningFromTags(tags) !== undefined,
+        license: getLicenseLabel(tags) || defaultLicense,
+        createdAt: event.created_at,
+      };
+    })
+    .sort((a, b) => b.createdAt - a.createdAt);
+}
+
+export function escapeHtml(value) {
+  return value
+    .replace(/&/g, '&amp;')
+    .replace(/</g, '&lt;')
+    .replace(/>/g, '&gt;')
+    .replace(/"/g, '&quot;')
+    .replace(/'/g, '&#39;');
+}
+
+/** Article counts per month (UTC), newest first. */
+export function getArchivePeriods(blogPosts) {
+  const counts = new Map();
+  for (const post of blogPosts) {
+    if (post.sensitive) continue;
+    const date = new Date(post.createdAt * 1000);
+    const key = `${date.getUTCFullYear()}-${date.getUTCMonth() + 1}`;
+    counts.set(key, (counts.get(key) || 0) + 1);
+  }
+
+  return [...counts.entries()]
+    .map(([key, count]) => {
+      const [year, month] = key.split('-').map(Number);
+      return { year, month, count };
+    })
+    .sort((a, b) => b.year - a.year || b.month - a.month);
+}
+
+/**
+ * Articles for the static feeds. Static files can't honour the `?nsfw=1`
+ * opt-in, so flagged posts are left out.
+ */
+export function getFeedArticles(blogPosts) {
+  return blogPosts.filter((post) => !post.sensitive);
+}
+
+/**
+ * Team members with public posts, for `/author/<name>` meta and the sitemap.
+ * Counts match what the author page lists without `?nsfw=1`.
+ */
+export function getAuthorPages(blogPosts, teamNames) {
+  return Object.entries(teamNames)
+    .map(([name, pubkey]) => {
+      const posts = blogPosts.filter((post) => post.pubkey === pubkey && !post.sensitive);
+      return { name, pubkey, count: posts.length, lastmod: posts[0]?.createdAt };
+    })
+    .filter((author) => author.count > 0);
+}
+
+/** RSS 2.0, with the article HTML in `content:encoded`. */
+export function buildRssXml(feed) {
+  const items = feed.items.map((item) => [
+    '    <item>',
+    `      <title>${escapeHtml(item.title)}</title>`,
+    `      <link>${escapeHtml(item.url)}</link>`,
+    `      <guid isPermaLink="true">${escapeHtml(item.id)}</guid>`,
+    `      <pubDate>${item.date.toUTCString()}</pubDate>`,
+    item.author ? `      <dc:creator>${escapeHtml(item.author)}</dc:creator>` : '',
+    `      <description>${escapeHtml(item.summary)}</description>`,
+    `      <content:encoded>${escapeHtml(item.html)}</content:encoded>`,
+    '    </item>',
+  ].filter(Boolean).join('\n'));
+
+  return `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom" xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>${escapeHtml(feed.title)}</title>
+    <link>${escapeHtml(feed.homeUrl)}</link>
+    <description>${escapeHtml(feed.description)}</description>
+    <atom:link href="${escapeHtml(feed.feedUrl)}" rel="self" type="application/rss+xml" />
+${feed.license ? `    <copyright>${escapeHtml(feed.license)}</copyright>\n` : ''}${items.join('\n')}
+  </channel>
+</rss>
+`;
+}
+
+/** Atom 1.0; `updated` is the newest item's date. */
+export function buildAtomXml(feed) {
+  const updated = (feed.items[0]?.date || new Date()).toISOString();
+  const entries = feed.items.map((item) => [
+    '  <entry>',
+    `    <title>${escapeHtml(item.title)}</title>`,
+    `    <link href="${escapeHtml(item.url)}" />`,
+    `    <id>${escapeHtml(item.id)}</id>`,
+    `    <updated>${item.date.toISOString()}</updated>`,
+    item.author ? `    <author><name>${escapeHtml(item.author)}</name></author>` : '',
+    `    <summary>${escapeHtml(item.summary)}</summary>`,
+    `    <content type="html">${escapeHtml(item.html)}</content>`,
+    item.license ? `    <rights>${escapeHtml(item.license)}</rights>` : '',
+    '  </entry>',
+  ].filter(Boolean).join('\n'));
+
+  return `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>${escapeHtml(feed.title)}</title>
+  <subtitle>${escapeHtml(feed.description)}</subtitle>
+  <link href="${escapeHtml(feed.homeUrl)}" />
+  <link href="${escapeHtml(feed.feedUrl)}" rel="self" />
+  <id>${escapeHtml(feed.homeUrl)}</id>
+  <updated>${updated}</updated>
+  <author><name>${escapeHtml(feed.title)}</name></author>
+${entries.join('\n')}
+</feed>
+`;
+}
+
+/** JSON Feed 1.1. */
+export function buildJsonFeed(feed) {
+  return `${JSON.stringify({
+    version: 'https://jsonfeed.org/version/1.1',
+    title: feed.title,
+    description: feed.description,
+    home_page_url: feed.homeUrl,
+    feed_url: feed.feedUrl,
+    items: feed.items.map((item) => ({
+      id: item.id,
+      url: item.url,
+      title: item.title,
+      summary: item.summary,
+      content_html: item.html,
+      date_published: item.date.toISOString(),
+      ...(item.author ? { authors: [{ name: item.author }] } : {}),
+    })),
+  }, null, 2)}\n`;
+}
+
+const ICON_TYPES = {
+  '.svg': 'image/svg+xml',
+  '.png': 'image/png',
+  '.jpg': 'image/jpeg',
+  '.jpeg': 'image/jpeg',
+  '.webp': 'image/webp',
+  '.gif': 'image/gif',
+  '.ico': 'image/x-icon',
+};
+
+/**
+ * Dimensions of uploaded rasters are unknown, and `sizes: "any"` on a raster
+ * makes browsers scale it to every icon size, so only SVGs claim "any".
+ */
+export function toManifestIcon(src) {
+  let extension = '';
+  try {
+    extension = new URL(src, 'https://localhost').pathname.match(/\.[^./]+$/)?.[0].toLowerCase() || '';
+  } catch {
+    // Leave the type off for unparseable URLs
+  }
+
+  const type = ICON_TYPES[extension];
+  return {
+    src,
+    ...(type ? { type } : {}),
+    ...(extension === '.svg' ? { sizes: 'any' } : {}),
+  };
+}
+
+/** Home screens truncate labels at around 12 characters */
+const SHORT_NAME_MAX_LENGTH = 12;
+
+export function toShortName(title) {
+  if (title.length <= SHORT_NAME_MAX_LENGTH) return title;
+
+  let shortName = '';
+  for (const word of title.split(/\s+/)) {
+    const candidate = shortName ? `${shortName} ${word}` : word;
+    if (candidate.length > SHORT_NAME_MAX_LENGTH) break;
+    shortName = candidate;
+  }
+  return shortName || title.slice(0, SHORT_NAME_MAX_LENGTH);
+}
@@ -0,0 +1,177 @@
+import { describe, expect, it } from 'vitest';
+import {
+  buildAtomXml,
+  buildJsonFeed,
+  buildRssXml,
+  escapeHtml,
+  getArchivePeriods,
+  getAuthorPages,
+  getFeedArticles,
+  getLatestArticles,
+  toManifestIcon,
+  toShortName,
+  type Feed,
+  type FeedItem,
+  type StaticArticle,
+} from './staticSite';
+
+const pubkey = 'e4690a13290739da123aa17d553851dec4cdd0e9d89aa18de3741c446caf8761';
+
+function event(id: string, created_at: number, tags: string[][], content = '') {
+  return { id, pubkey, created_at, tags, content };
+}
+
+function article(overrides: Partial<StaticArticle>): StaticArticle {
+  return {
+    id: 'id',
+    pubkey,
+    d: 'd',
+    title: 'Title',
+    content: '',
+    summary: '',
+    image: '',
+    contentWarning: undefined,
+    sensitive: false,
+    license: '',
+    createdAt: 0,
+    ...overrides,
+  };
+}
+
+function feed(overrides: Partial<FeedItem> = {}): Feed {
+  return {
+    title: 'Tom & Jerry\'s "Blog"',
+    description: 'Posts <weekly>',
+    homeUrl: 'https://example.com/blog',
+    feedUrl: 'https://example.com/feed.xml',
+    license: '',
+    items: [{
+      id: 'https://example.com/naddr1abc',
+      url: 'https://example.com/blog/abc',
+      title: 'Fish & <Chips>',
+      author: '',
+      summary: 'A "quoted" summary',
+      html: '<p>Body & more</p>',
+      license: '',
+      date: new Date(Date.UTC(2024, 0, 15)),
+      ...overrides,
+    }],
+  };
+}
+
+describe('escapeHtml', () => {
+  it('escapes markup and quote characters', () => {
+    expect(escapeHtml(`<a href="x">Tom & Jerry's</a>`)).toBe('&lt;a href=&quot;x&quot;&gt;Tom &amp; Jerry&#39;s&lt;/a&gt;');
+  });
+});
+
+describe('getLatestArticles', () => {
+  it('keeps only the newest revision of an article', () => {
+    const articles = getLatestArticles([
+      event('old', 1, [['d', 'post'], ['title', 'Old title']]),
+      event('new', 2, [['d', 'post'], ['title', 'New title']]),
+      event('other', 3, [['d', 'other']]),
+    ], '');
+
+    expect(articles.map((post) => [post.id, post.title])).toEqual([['other', 'Untitled'], ['new', 'New title']]);
+  });
+
+  it('drops an article whose newest revision is unpublished', () => {
+    const articles = getLatestArticles([
+      event('published', 1, [['d', 'post'], ['published', 'true']]),
+      event('unpublished', 2, [['d', 'post'], ['published', 'false']]),
+    ], '');
+
+    expect(articles).toEqual([]);
+  });
+
+  it('flags content warnings and falls back to the default license', () => {
+    const [post] = getLatestArticles([
+      event('a', 1, [['d', 'post'], ['content-warning', 'spoilers']]),
+    ], 'CC-BY-4.0');
+
+    expect(post).toMatchObject({ sensitive: true, contentWarning: 'spoilers', license: 'CC-BY-4.0' });
+  });
+});
+
+describe('sensitive posts', () => {
+  const posts = [
+    article({ id: 'february', createdAt: Date.UTC(2024, 1, 1, 0, 30) / 1000 }),
+    article({ id: 'january', createdAt: Date.UTC(2024, 0, 31, 23) / 1000 }),
+    article({ id: 'flagged', sensitive: true, createdAt: Date.UTC(2024, 0, 20) / 1000 }),
+  ];
+
+  it('leaves flagged posts out of the feeds', () => {
+    expect(getFeedArticles(posts).map((post) => post.id)).toEqual(['february', 'january']);
+  });
+
+  it('counts archive months in UTC without flagged posts', () => {
+    expect(getArchivePeriods(posts)).toEqual([
+      { year: 2024, month: 2, count: 1 },
+      { year: 2024, month: 1, count: 1 },
+    ]);
+  });
+
+  it('lists authors by their public posts only', () => {
+    const flaggedOnly = 'f'.repeat(64);
+    const authors = getAuthorPages(
+      [...posts, article({ pubkey: flaggedOnly, sensitive: true })],
+      { alice: pubkey, bob: flaggedOnly },
+    );
+
+    expect(authors).toEqual([{ name: 'alice', pubkey, count: 2, lastmod: posts[0].createdAt }]);
+  });
+});
+
+describe('feed builders', () => {
+  it('escapes RSS fields and uses the item id as guid', () => {
+    const xml = buildRssXml(feed());
+
+    expect(xml).toContain('<title>Tom &amp; Jerry&#39;s &quot;Blog&quot;</title>');
+    expect(xml).toContain('<title>Fish &amp; &lt;Chips&gt;</title>');
+    expect(xml).toContain('<guid isPermaLink="true">https://example.com/naddr1abc</guid>');
+    expect(xml).toContain('<content:encoded>&lt;p&gt;Body &amp; more&lt;/p&gt;</content:encoded>');
+    expect(xml).not.toContain('<copyright>');
+  });
+
+  it('escapes Atom fields and adds rights per item', () => {
+    const xml = buildAtomXml(feed({ license: 'CC-BY-4.0', author: 'alice' }));
+
+    expect(xml).toContain('<id>https://example.com/naddr1abc</id>');
+    expect(xml).toContain('<summary>A &quot;quoted&quot; summary</summary>');
+    expect(xml).toContain('<rights>CC-BY-4.0</rights>');
+    expect(xml).toContain('<author><name>alice</name></author>');
+    expect(xml).toContain('<updated>2024-01-15T00:00:00.000Z</updated>');
+  });
+
+  it('writes JSON Feed items without HTML escaping', () => {
+    const json = JSON.parse(buildJsonFeed(feed()));
+
+    expect(json.items[0]).toEqual({
+      id: 'https://example.com/naddr1abc',
+      url: 'https://example.com/blog/abc',
+      title: 'Fish & <Chips>',
+      summary: 'A "quoted" summary',
+      content_html: '<p>Body & more</p>',
+      date_published: '2024-01-15T00:00:00.000Z',
+    });
+  });
+});
+
+describe('manifest helpers', () => {
+  it('types icons by extension and only sizes SVGs as "any"', () => {
+    expect(toManifestIcon('https://cdn.example.com/logo.SVG?v=2')).toEqual({
+      src: 'https://cdn.example.com/logo.SVG?v=2',
+      type: 'image/svg+xml',
+      sizes: 'any',
+    });
+    expect(toManifestIcon('/favicon.png')).toEqual({ src: '/favicon.png', type: 'image/png' });
+    expect(toManifestIcon('https://blossom.example.com/abc123')).toEqual({ src: 'https://blossom.example.com/abc123' });
+  });
+
+  it('shortens long titles at a word boundary', () => {
+    expect(toShortName('Meetup')).toBe('Meetup');
+    expect(toShortName('Bitcoin Meetup Berlin')).toBe('Bitcoin');
+    expect(toShortName('Supercalifragilistic')).toBe('Supercalifra');
+  });
+});
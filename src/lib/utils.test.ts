@@ -0,0 +1,24 @@
+import { nip19 } from 'nostr-tools';
+import { describe, expect, it } from 'vitest';
+import { normalizeToHexPubkeys } from './utils';
+
+const hex = '79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798';
+const npub = nip19.npubEncode(hex);
+// Flip the last checksum character to another valid bech32 character
+const corruptedNpub = npub.slice(0, -1) + (npub.endsWith('q') ? 'p' : 'q');
+
+describe('normalizeToHexPubkeys', () => {
+  it.each([
+    ['hex pubkey', hex, [hex]],
+    ['uppercase hex pubkey', hex.toUpperCase(), [hex]],
+    ['npub', npub, [hex]],
+    ['npub with whitespace', `  ${npub} `, [hex]],
+    ['npub with a bad checksum', corruptedNpub, []],
+    ['truncated npub', npub.slice(0, -6), []],
+    ['note id', nip19.noteEncode(hex), []],
+    ['short hex', hex.slice(0, 62), []],
+    ['empty string', '', []],
+  ])('%s', (_, input, expected) => {
+    expect(normalizeToHexPubkeys([input])).toEqual(expected);
+  });
+});
@@ -0,0 +1,221 @@
+import { useMemo } from 'react';
+import { useSeoMeta } from '@unhead/react';
+import { Link, Navigate, useParams, useSearchParams } from 'react-router-dom';
+import { useQuery } from '@tanstack/react-query';
+import { format } from 'date-fns';
+import { Calendar, ChevronLeft, ChevronRight, Edit } from 'lucide-react';
+import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card';
+import { Button } from '@/components/ui/button';
+import { PageLoadingIndicator } from '@/components/PageLoadingIndicator';
+import Navigation from '@/components/Navigation';
+import { AuthorInfo } from '@/components/AuthorInfo';
+import { useDefaultRelay } from '@/hooks/useDefaultRelay';
+import { useAppContext } from '@/hooks/useAppContext';
+import { getMasterPubkey } from '@/lib/relay';
+import { hasSensitiveOptIn, isSensitive } from '@/lib/contentWarning';
+import { getLatestRevisions } from '@/lib/latestRevisions';
+import { cn } from '@/lib/utils';
+
+interface ArchivePost {
+  id: string;
+  title: string;
+  created_at: number;
+  pubkey: string;
+  sensitive: boolean;
+}
+
+function parsePeriod(yearParam?: string, monthParam?: string) {
+  const year = Number(yearParam);
+  if (!Number.isInteger(year) || year < 1970 || year > 9999) return null;
+  if (monthParam === undefined) return { year, month: undefined };
+
+  const month = Number(monthParam);
+  if (!Number.isInteger(month) || month < 1 || month > 12) return null;
+  return { year, month };
+}
+
+function archivePath(year: number, month?: number) {
+  return month ? `/archive/${year}/${String(month).padStart(2, '0')}` : `/archive/${year}`;
+}
+
+/** Unix seconds at the start of a UTC month; month 12 rolls over to January. */
+function utcMonthStart(year: number, monthIndex: number) {
+  return Date.UTC(year, monthIndex, 1) / 1000;
+}
+
+/**
+ * Published articles for one year, grouped by month. Only the requested year
+ * is fetched, using the relay's `since`/`until` range filter. Periods are UTC
+ * months, matching the `archive.json` written by the build.
+ */
+export default function ArchivePage() {
+  const { year: yearParam, month: monthParam } = useParams<{ year: string; month: string }>();
+  const { config } = useAppContext();
+  const { nostr } = useDefaultRelay();
+  const [searchParams] = useSearchParams();
+  const showSensitive = hasSensitiveOptIn(searchParams);
+  // Keep the opt-in when moving between periods
+  const search = showSensitive ? `?${searchParams.toString()}` : '';
+  const period = parsePeriod(yearParam, monthParam);
+  const year = period?.year ?? new Date().getFullYear();
+
+  const { data: posts = [], isLoading } = useQuery({
+    queryKey: ['archive-posts', year, config.siteConfig?.adminRoles],
+    queryFn: async (): Promise<ArchivePost[]> => {
+      const since = utcMonthStart(year, 0);
+      const until = utcMonthStart(year, 12) - 1;
+      const events = await nostr!.query([
+        { kinds: [30023], since, until, limit: 500 }
+      ], { signal: AbortSignal.timeout(5000) });
+
+      const adminRoles = config.siteConfig?.adminRoles || {};
+      const masterPubkey = getMasterPubkey();
+
+      // Relays may return several revisions of an edited article; keep the latest
+      return getLatestRevisions(events)
+        .filter(event => {
+          const authorPubkey = event.pubkey.toLowerCase().trim();
+          if (authorPubkey !== masterPubkey && adminRoles[authorPubkey] !== 'primary') return false;
+          return event.tags.find(([name]) => name === 'published')?.[1] !== 'false';
+        })
+        .map(event => ({
+          id: event.id,
+          title: event.tags.find(([name]) => name === 'title')?.[1] || 'Untitled',
+          created_at: event.created_at,
+          pubkey: event.pubkey,
+          sensitive: isSensitive(event),
+        }))
+        .sort((a, b) => b.created_at - a.created_at);
+    },
+    enabled: !!nostr && !!period,
+  });
+
+  // Same rule as the blog listing: flagged posts only with `?nsfw=1`
+  const listedPosts = useMemo(
+    () => posts.filter(post => showSensitive || !post.sensitive),
+    [posts, showSensitive],
+  );
+
+  const monthCounts = useMemo(() => {
+    const counts = new Array<number>(12).fill(0);
+    for (const post of listedPosts) {
+      counts[new Date(post.created_at * 1000).getUTCMonth()] += 1;
+    }
+    return counts;
+  }, [listedPosts]);
+
+  const visiblePosts = useMemo(() => {
+    if (!period?.month) return listedPosts;
+    const start = utcMonthStart(year, period.month - 1);
+    const end = utcMonthStart(year, period.month);
+    return listedPosts.filter(post => post.created_at >= start && post.created_at < end);
+  }, [listedPosts, period?.month, year]);
+
+  const siteTitle = config.siteConfig?.title || 'Community Meetup';
+  const periodLabel = period?.month ? format(new Date(year, period.month - 1), 'MMMM yyyy') : String(year);
+  const pageTitle = `Archive: ${periodLabel} - ${siteTitle}`;
+  const pageDescription = `Blog posts published in ${periodLabel}.`;
+
+  useSeoMeta({
+    title: pageTitle,
+    description: pageDescription,
+    ogTitle: pageTitle,
+    ogDescription: pageDescription,
+    ogType: 'website',
+    ogImage: config.siteConfig?.ogImage,
+    twitterCard: 'summary_large_image',
+    twitterTitle: pageTitle,
+    twitterDescription: pageDescription,
+    twitterImage: config.siteConfig?.ogImage,
+  });
+
+  if (!yearParam) {
+    return <Navigate to={archivePath(new Date().getFullYear()) + search} replace />;
+  }
+
+  if (!period) {
+    return <Navigate to="/archive" replace />;
+  }
+
+  if (isLoading) {
+    return <PageLoadingIndicator />;
+  }
+
+  return (
+    <div className="min-h-screen">
+      <Navigation />
+      <div className="py-8">
+        <div className="max-w-4xl mx-auto px-4 space-y-6">
+          <div className="flex items-center justify-between gap-4">
+            <div>
+              <h1 className="text-3xl font-bold tracking-tight mb-2">Archive</h1>
+              <p className="text-lg text-muted-foreground">
+                {visiblePosts.length} {visiblePosts.length === 1 ? 'post' : 'posts'} in {periodLabel}
+              </p>
+            </div>
+            <div className="flex gap-1">
+              <Button variant="outline" size="icon" asChild title="Previous year">
+                <Link to={archivePath(year - 1) + search}><ChevronLeft className="h-4 w-4" /></Link>
+              </Button>
+              <Button variant="outline" size="icon" asChild title="Next year">
+                <Link to={archivePath(year + 1) + search}><ChevronRight className="h-4 w-4" /></Link>
+              </Button>
+            </div>
+          </div>
+
+          <Card>
+            <CardContent className="pt-6">
+              <div className="grid grid-cols-3 sm:grid-cols-6 gap-2">
+                {monthCounts.map((count, index) => (
+                  <Button
+                    key={index}
+                    variant={period.month === index + 1 ? 'default' : 'ghost'}
+                    size="sm"
+                    className={cn('justify-between', count === 0 && 'text-muted-foreground')}
+                    asChild
+                  >
+                    <Link to={(period.month === index + 1 ? archivePath(year) : archivePath(year, index + 1)) + search}>
+                      {format(new Date(year, index), 'MMM')}
+                      <span className="text-xs">{count}</span>
+                    </Link>
+                  </Button>
+                ))}
+              </div>
+            </CardContent>
+          </Card>
+
+          {visiblePosts.length > 0 ? (
+            <div className="grid grid-cols-1 gap-4">
+              {visiblePosts.map(post => (
+                <Card key={post.id} className="hover:shadow-lg transition-shadow">
+                  <CardHeader className="pb-2">
+                    <div className="flex items-center justify-between gap-4">
+                      <CardTitle className="text-lg line-clamp-2">
+                        <Link to={`/blog/${post.id}`} className="hover:underline">{post.title}</Link>
+                      </CardTitle>
+                      <div className="flex items-center gap-2 text-sm text-muted-foreground shrink-0">
+                        <Calendar className="h-4 w-4" />
+                        {new Date(post.created_at * 1000).toLocaleDateString()}
+                      </div>
+                    </div>
+                  </CardHeader>
+                  <CardContent>
+                    <AuthorInfo pubkey={post.pubkey} />
+                  </CardContent>
+                </Card>
+              ))}
+            </div>
+          ) : (
+            <Card>
+              <CardContent className="py-12 text-center">
+                <Edit className="h-12 w-12 text-muted-foreground mx-auto mb-4" />
+                <h3 className="text-lg font-semibold mb-2">No posts in {periodLabel}</h3>
+                <p className="text-muted-foreground">Pick another month or year.</p>
+              </CardContent>
+            </Card>
+          )}
+        </div>
+      </div>
+    </div>
+  );
+}
@@ -0,0 +1,229 @@
+import { useMemo } from 'react';
+import { useParams, useSearchParams, Link } from 'react-router-dom';
+import { useInfiniteQuery } from '@tanstack/react-query';
+import { useSeoMeta } from '@unhead/react';
+import { nip19 } from 'nostr-tools';
+import Navigation from '@/components/Navigation';
+import { PageLoadingIndicator } from '@/components/PageLoadingIndicator';
+import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card';
+import { Button } from '@/components/ui/button';
+import { Avatar, AvatarFallback, AvatarImage } from '@/components/ui/avatar';
+import { useDefaultRelay } from '@/hooks/useDefaultRelay';
+import { useAppContext } from '@/hooks/useAppContext';
+import { useAuthor } from '@/hooks/useAuthor';
+import { getNostrJsonDomain, useRemoteNostrJson } from '@/hooks/useRemoteNostrJson';
+import { getMasterPubkey } from '@/lib/relay';
+import { hasSensitiveOptIn, isSensitive } from '@/lib/contentWarning';
+import { getLatestRevisions } from '@/lib/latestRevisions';
+import type { NostrEvent } from '@nostrify/nostrify';
+import { Calendar, Edit, ExternalLink, Loader2 } from 'lucide-react';
+
+const PAGE_SIZE = 20;
+
+interface AuthorPost {
+  id: string;
+  title: string;
+  summary: string;
+  created_at: number;
+  sensitive: boolean;
+}
+
+interface AuthorPostsPage {
+  /** Every event the relay returned, unpublished revisions included */
+  events: NostrEvent[];
+  /** Oldest `created_at` the relay returned, used as the next `until` */
+  cursor?: number;
+}
+
+/**
+ * Author hub: profile, NIP-05 handle and published long-form posts for a
+ * team member listed in nostr.json.
+ */
+export default function AuthorPage() {
+  const { name = '' } = useParams<{ name: string }>();
+  const { nostr } = useDefaultRelay();
+  const { config } = useAppContext();
+  const { data: nostrJson, isLoading: nostrJsonLoading } = useRemoteNostrJson();
+  const [searchParams] = useSearchParams();
+  const showSensitive = hasSensitiveOptIn(searchParams);
+
+  const names = nostrJson?.names;
+  // `name` comes from the URL, so ignore inherited keys like `constructor`
+  const pubkey = names && Object.hasOwn(names, name) && typeof names[name] === 'string'
+    ? names[name].toLowerCase().trim()
+    : undefined;
+  const { data: author } = useAuthor(pubkey);
+
+  const adminRoles = config.siteConfig?.adminRoles || {};
+  const masterPubkey = getMasterPubkey();
+  // Only master and primary admins publish to the public blog
+  const canPublishPublicly = !!pubkey && (pubkey === masterPubkey || adminRoles[pubkey] === 'primary');
+
+  const {
+    data,
+    isLoading: postsLoading,
+    fetchNextPage,
+    hasNextPage,
+    isFetchingNextPage,
+  } = useInfiniteQuery({
+    queryKey: ['author-posts', pubkey, canPublishPublicly],
+    queryFn: async ({ pageParam }): Promise<AuthorPostsPage> => {
+      if (!pubkey || !canPublishPublicly) return { events: [] };
+
+      const events = await nostr!.query([{
+        kinds: [30023],
+        authors: [pubkey],
+        limit: PAGE_SIZE,
+        ...(pageParam ? { until: pageParam } : {}),
+      }], { signal: AbortSignal.timeout(5000) });
+
+      const sorted = events.sort((a, b) => b.created_at - a.created_at);
+
+      return {
+        events: sorted,
+        cursor: sorted[sorted.length - 1]?.created_at,
+      };
+    },
+    initialPageParam: undefined as number | undefined,
+    getNextPageParam: (lastPage, _allPages, lastPageParam) => {
+      // A short page from the relay means there is nothing older
+      if (lastPage.events.length < PAGE_SIZE || lastPage.cursor === undefined) return undefined;
+      // `until` is inclusive, so posts sharing the boundary timestamp are fetched
+      // again and deduped below. Step past it only if a whole page shares it.
+      return lastPage.cursor === lastPageParam ? lastPage.cursor - 1 : lastPage.cursor;
+    },
+    enabled: !!nostr && !!pubkey,
+  });
+
+  // Revisions of one article can land on different pages, so collapse them
+  // across all pages before dropping unpublished ones, as the archive does
+  const posts = useMemo(() => {
+    return getLatestRevisions((data?.pages ?? []).flatMap(page => page.events))
+      .filter(event => event.tags.find(([tagName]) => tagName === 'published')?.[1] !== 'false')
+      .map((event): AuthorPost => ({
+        id: event.id,
+        title: event.tags.find(([tagName]) => tagName === 'title')?.[1] || 'Untitled',
+        summary: event.tags.find(([tagName]) => tagName === 'summary')?.[1] || event.content.replace(/[*#>`]/g, '').slice(0, 200),
+        created_at: event.created_at,
+        sensitive: isSensitive(event),
+      }))
+      .filter(post => showSensitive || !post.sensitive);
+  }, [data, showSensitive]);
+
+  const displayName = author?.metadata?.display_name || author?.metadata?.name || name;
+  const siteTitle = config.siteConfig?.title || 'Community Meetup Site';
+  const nip05 = typeof window !== 'undefined' ? `${name}@${getNostrJsonDomain()}` : name;
+
+  let npub = '';
+  if (pubkey && /^[0-9a-f]{64}$/.test(pubkey)) {
+    npub = nip19.npubEncode(pubkey);
+  }
+
+  useSeoMeta({
+    title: `${displayName} - ${siteTitle}`,
+    description: author?.metadata?.about || `Posts by ${displayName}`,
+    ogTitle: `${displayName} - ${siteTitle}`,
+    ogDescription: author?.metadata?.about || `Posts by ${displayName}`,
+    ogType: 'profile',
+    ogImage: author?.metadata?.picture || config.siteConfig?.ogImage,
+  });
+
+  if (nostrJsonLoading || (pubkey && postsLoading)) {
+    return <PageLoadingIndicator />;
+  }
+
+  if (!pubkey) {
+    return (
+      <div className="min-h-screen">
+        <Navigation />
+        <div className="max-w-3xl mx-auto px-4 py-16 text-center">
+          <h1 className="text-2xl font-bold mb-4">Author not found</h1>
+          <p className="text-muted-foreground mb-8">There is no team member named "{name}" on this site.</p>
+          <Button asChild>
+            <Link to="/blog">Back to Blog</Link>
+          </Button>
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <div className="min-h-screen">
+      <Navigation />
+      <div className="py-8">
+        <div className="max-w-4xl mx-auto px-4 space-y-6">
+          {/* Profile header */}
+          <div className="flex items-center gap-4">
+            <Avatar className="h-16 w-16">
+              <AvatarImage src={author?.metadata?.picture} alt={displayName} />
+              <AvatarFallback>{displayName.charAt(0)}</AvatarFallback>
+            </Avatar>
+            <div className="space-y-1">
+              <h1 className="text-3xl font-bold tracking-tight">{displayName}</h1>
+              <p className="text-sm text-muted-foreground">{nip05}</p>
+              {npub && (
+                <a
+                  href={`${config.siteConfig?.nip19Gateway || 'https://nostr.at'}/${npub}`}
+                  target="_blank"
+                  rel="noopener noreferrer"
+                  className="text-xs text-muted-foreground hover:underline flex items-center gap-1"
+                >
+                  {npub.slice(0, 12)}...{npub.slice(-4)}
+                  <ExternalLink className="h-3 w-3" />
+                </a>
+              )}
+            </div>
+          </div>
+
+          {author?.metadata?.about && (
+            <p className="text-muted-foreground whitespace-pre-line">{author.metadata.about}</p>
+          )}
+
+          {/* Posts */}
+          {posts.length > 0 ? (
+            <div className="grid grid-cols-1 gap-6">
+              {posts.map((post) => (
+                <Card key={post.id} className="hover:shadow-lg transition-shadow">
+                  <CardHeader>
+                    <div className="flex items-center justify-between">
+                      <CardTitle className="text-xl line-clamp-2">{post.title}</CardTitle>
+                      <div className="flex items-center gap-2 text-sm text-muted-foreground">
+                        <Calendar className="h-4 w-4" />
+                        {new Date(post.created_at * 1000).toLocaleDateString()}
+                      </div>
+                    </div>
+                  </CardHeader>
+                  <CardContent>
+                    <p className="text-muted-foreground line-clamp-3 mb-4">{post.summary}</p>
+                    <div className="flex justify-end">
+                      <Button asChild>
+                        <Link to={`/blog/${post.id}`}>Read More</Link>
+                      </Button>
+                    </div>
+                  </CardContent>
+                </Card>
+              ))}
+
+              {hasNextPage && (
+                <div className="flex justify-center">
+                  <Button variant="outline" onClick={() => fetchNextPage()} disabled={isFetchingNextPage}>
+                    {isFetchingNextPage && <Loader2 className="h-4 w-4 mr-2 animate-spin" />}
+                    Load more
+                  </Button>
+                </div>
+              )}
+            </div>
+          ) : (
+            <Card>
+              <CardContent className="py-12 text-center">
+                <Edit className="h-12 w-12 text-muted-foreground mx-auto mb-4" />
+                <h3 className="text-lg font-semibold mb-2">No published posts yet</h3>
+                <p className="text-muted-foreground">Check back soon for new content!</p>
+              </CardContent>
+            </Card>
+          )}
+        </div>
+      </div>
+    </div>
+  );
+}
@@ -1,6 +1,6 @@
 import { useState } from 'react';
 import { useSeoMeta } from '@unhead/react';
-import { Link } from 'react-router-dom';
+import { Link, useSearchParams } from 'react-router-dom';
 import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card';
 import { Button } from '@/components/ui/button';
 import { Input } from '@/components/ui/input';
@@ -8,9 +8,13 @@ import { PageLoadingIndicator } from '@/components/PageLoadingIndicator';
 import { useQuery } from '@tanstack/react-query';
 import { useDefaultRelay } from '@/hooks/useDefaultRelay';
 import { getMasterPubkey } from '@/lib/relay';
+import { sortByFeatured, EMPTY_FEATURED_LIST } from '@/lib/featured';
+import { useFeaturedContent } from '@/hooks/useFeaturedContent';
+import { getLatestRevisions } from '@/lib/latestRevisions';
+import { hasSensitiveOptIn, isSensitive } from '@/lib/contentWarning';
 import { useAppContext } from '@/hooks/useAppContext';
 import Navigation from '@/components/Navigation';
-import { Search, Calendar, Edit, RefreshCw } from 'lucide-react';
+import { Search, Calendar, Edit, RefreshCw, Archive } from 'lucide-react';
 import { AuthorInfo } from '@/components/AuthorInfo';
 import ReactMarkdown from 'react-markdown';
 import remarkGfm from 'remark-gfm';
@@ -23,6 +27,8 @@ interface BlogPost {
   created_at: number;
   image?: string;
   pubkey: string;
+  d: string;
+  sensitive: boolean;
 }
 
 export default function BlogPage() {
@@ -30,14 +36,19 @@ export default function BlogPage() {
   const { nostr } = useDefaultRelay();
   const [searchTerm, setSearchTerm] = useState('');
   const [isRefreshing, setIsRefreshing] = useState(false);
+  const { data: featuredList = EMPTY_FEATURED_LIST } = useFeaturedContent();
+  const [searchParams] = useSearchParams();
+  const showSensitive = hasSensitiveOptIn(searchParams);
 
   const { data: posts = [], isLoading, refetch } = useQuery({
     queryKey: ['blog-posts', config.siteConfig?.adminRoles],
     queryFn: async () => {
       const signal = AbortSignal.timeout(5000);
-      const postList = await nostr!.query([
+      const events = await nostr!.query([
         { kinds: [30023], limit: 100 }
       ], { signal });
+      // Relays may return several revisions of an edited article; keep the latest
+      const postList = getLatestRevisions(events);
       
       const adminRoles = config.siteConfig?.adminRoles || {};
       const masterPubkey = getMasterPubkey();
@@ -58,6 +69,8 @@ export default function BlogPage() {
         published: event.tags.find(([name]) => name === 'published')?.[1] === 'true' || !event.tags.find(([name]) => name === 'published'),
         created_at: event.created_at,
         pubkey: event.pubkey,
+        d: event.tags.find(([name]) => name === 'd')?.[1] || '',
+        sensitive: isSensitive(event),
       })) as BlogPost[];
     },
     enabled: !!nostr,
@@ -72,13 +85,13 @@ export default function BlogPage() {
     }
   };
 
-  const filteredPosts = posts.filter(post => 
-    post.published && (
+  const filteredPosts = sortByFeatured(posts.filter(post => 
+    post.published && (showSensitive || !post.sensitive) && (
       searchTerm === '' ||
       post.title.toLowerCase().includes(searchTerm.toLowerCase()) ||
       post.content.toLowerCase().includes(searchTerm.toLowerCase())
     )
-  );
+  ), featuredList);
 
   const siteTitle = config.siteConfig?.title || 'Community Meetup';
   const pageTitle = `Blog - ${siteTitle}`;
@@ -113,11 +126,17 @@ export default function BlogPage() {
             <p className="text-lg text-muted-foreground">
               Read our latest community updates and insights
             </p>
-            <div className="mt-4">
+            <div className="mt-4 flex gap-2">
               <Button variant="outline" onClick={handleRefresh} disabled={isRefreshing}>
                 <RefreshCw className={`h-4 w-4 mr-2 ${isRefreshing ? 'animate-spin' : ''}`} />
                 Refresh Posts
               </Button>
+              <Button variant="outline" asChild>
+                <Link to="/archive">
+                  <Archive className="h-4 w-4 mr-2" />
+                  Archive
+                </Link>
+              </Button>
             </div>
           </div>
 
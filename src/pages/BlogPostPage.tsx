@@ -4,18 +4,24 @@ import { useDefaultRelay } from '@/hooks/useDefaultRelay';
 import Navigation from '@/components/Navigation';
 import { PageLoadingIndicator } from '@/components/PageLoadingIndicator';
 import { Button } from '@/components/ui/button';
-import { Calendar, ArrowLeft } from 'lucide-react';
+import { Calendar, ArrowLeft, Link2, BookOpen } from 'lucide-react';
 import ReactMarkdown from 'react-markdown';
 import remarkGfm from 'remark-gfm';
-import { useSeoMeta } from '@unhead/react';
+import { useHead, useSeoMeta } from '@unhead/react';
 import { useAppContext } from '@/hooks/useAppContext';
 import { getMasterPubkey } from '@/lib/relay';
 import { AuthorInfo } from '@/components/AuthorInfo';
+import { getLicense, getLicenseUrl } from '@/lib/license';
+import { getArticleNaddr, getArticlePermalink } from '@/lib/permalink';
+import { getContentWarning } from '@/lib/contentWarning';
+import { SensitiveContent } from '@/components/SensitiveContent';
+import { useToast } from '@/hooks/useToast';
 
 export default function BlogPostPage() {
   const { postId } = useParams<{ postId: string }>();
   const { nostr } = useDefaultRelay();
   const { config } = useAppContext();
+  const { toast } = useToast();
 
   const { data: post, isLoading } = useQuery({
     queryKey: ['blog-post', postId, config.siteConfig?.adminRoles],
@@ -43,19 +49,47 @@ export default function BlogPostPage() {
         published,
         created_at: event.created_at,
         pubkey: event.pubkey,
+        d: event.tags.find(([name]) => name === 'd')?.[1] || '',
         image: event.tags.find(([name]) => name === 'image')?.[1],
+        license: getLicense(event.tags),
+        contentWarning: getContentWarning(event),
       };
     },
     enabled: !!nostr,
   });
 
+  const sensitive = post?.contentWarning !== undefined;
+  // Flagged posts never leak body text or images into link previews
+  const previewImage = (!sensitive && post?.image) || config.siteConfig?.ogImage;
+
   useSeoMeta({
     title: post ? `${post.title} - ${config.siteConfig?.title || 'Blog'}` : 'Blog Post',
-    description: post ? post.content.slice(0, 160) : 'Read this blog post on our community site.',
-    ogImage: post?.image || config.siteConfig?.ogImage,
-    twitterImage: post?.image || config.siteConfig?.ogImage,
+    description: post
+      ? sensitive ? 'This post contains sensitive content.' : post.content.slice(0, 160)
+      : 'Read this blog post on our community site.',
+    ogImage: previewImage,
+    twitterImage: previewImage,
   });
 
+  // Script-free copy written by the build; /r/ falls back to this page for newer posts
+  const readerPath = post?.d ? `/r/${getArticleNaddr(post.pubkey, post.d)}` : undefined;
+
+  useHead({
+    link: readerPath ? [{ key: 'reader-view', rel: 'alternate', type: 'text/html', title: 'Reader view', href: readerPath }] : [],
+  });
+
+  const license = post?.license || config.siteConfig?.defaultLicense;
+  const licenseUrl = license ? getLicenseUrl(license) : undefined;
+
+  const handleCopyPermalink = () => {
+    if (!post?.d) return;
+    navigator.clipboard.writeText(getArticlePermalink(post.pubkey, post.d));
+    toast({
+      title: "Link Copied",
+      description: "Permalink copied to clipboard."
+    });
+  };
+
   if (isLoading) {
     return <PageLoadingIndicator />;
   }
@@ -86,7 +120,7 @@ export default function BlogPostPage() {
           </Link>
         </Button>
 
-        {post.image && (
+        {post.image && !sensitive && (
           <img 
             src={post.image} 
             alt={post.title} 
@@ -103,16 +137,53 @@ export default function BlogPostPage() {
               month: 'long',
               day: 'numeric'
             })}</time>
+            {readerPath && (
+              <div className="ml-auto flex gap-1">
+                <Button variant="ghost" size="sm" asChild title="Plain page for slow connections and read-later services">
+                  {/* Full page load: the reader view is a static file, not an app route */}
+                  <a href={readerPath}>
+                    <BookOpen className="mr-2 h-4 w-4" />
+                    Reader view
+                  </a>
+                </Button>
+                <Button variant="ghost" size="sm" onClick={handleCopyPermalink} title="Copy a link that follows future edits">
+                  <Link2 className="mr-2 h-4 w-4" />
+                  Copy permalink
+                </Button>
+              </div>
+            )}
           </div>
         </header>
 
         <AuthorInfo pubkey={post.pubkey} size="lg" showNpub={true} className="flex items-center gap-3 py-6 border-y mb-8" />
 
-        <div className="prose prose-lg dark:prose-invert max-w-none">
-          <ReactMarkdown remarkPlugins={[remarkGfm]}>
-            {post.content}
-          </ReactMarkdown>
-        </div>
+        <SensitiveContent warning={post.contentWarning}>
+          {post.image && sensitive && (
+            <img
+              src={post.image}
+              alt={post.title}
+              className="w-full h-auto aspect-video object-cover rounded-xl mb-8"
+            />
+          )}
+          <div className="prose prose-lg dark:prose-invert max-w-none">
+            <ReactMarkdown remarkPlugins={[remarkGfm]}>
+              {post.content}
+            </ReactMarkdown>
+          </div>
+        </SensitiveContent>
+
+        {license && (
+          <footer className="mt-12 pt-6 border-t text-sm text-muted-foreground">
+            Licensed under{' '}
+            {licenseUrl ? (
+              <a href={licenseUrl} target="_blank" rel="license noopener noreferrer" className="underline hover:text-foreground">
+                {license}
+              </a>
+            ) : (
+              <span>{license}</span>
+            )}
+          </footer>
+        )}
       </article>
     </div>
   );
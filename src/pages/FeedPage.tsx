@@ -4,6 +4,7 @@ import { useNostr } from '@nostrify/react';
 import { type NostrEvent } from '@nostrify/nostrify';
 import { useInView } from 'react-intersection-observer';
 import { useSeoMeta } from '@unhead/react';
+import { useSearchParams } from 'react-router-dom';
 import { LayoutGrid, Rss, AlertCircle, Loader2 } from 'lucide-react';
 
 import { useAppContext } from '@/hooks/useAppContext';
@@ -14,10 +15,13 @@ import { Card, CardContent } from '@/components/ui/card';
 import { Button } from '@/components/ui/button';
 
 import { normalizeToHexPubkeys } from '@/lib/utils';
+import { hasSensitiveOptIn, isSensitive } from '@/lib/contentWarning';
 
 export default function FeedPage() {
   const { config } = useAppContext();
   const { nostr } = useNostr();
+  const [searchParams] = useSearchParams();
+  const showSensitive = hasSensitiveOptIn(searchParams);
 
   const siteConfig = config.siteConfig;
 
@@ -85,9 +89,11 @@ export default function FeedPage() {
     staleTime: 60000, // 1 minute
   });
 
+  // Flagged notes are hidden unless the URL opts in with ?nsfw=1
   const notes = useMemo(() => {
-    return notesData?.pages.flat() || [];
-  }, [notesData]);
+    const all = notesData?.pages.flat() || [];
+    return showSensitive ? all : all.filter(note => !isSensitive(note));
+  }, [notesData, showSensitive]);
 
   // Load more when scrolled to bottom
   useEffect(() => {
@@ -9,6 +9,10 @@ import { PageLoadingIndicator } from '@/components/PageLoadingIndicator';
 import { useAppContext } from '@/hooks/useAppContext';
 import { useDefaultRelay } from '@/hooks/useDefaultRelay';
 import { getMasterPubkey } from '@/lib/relay';
+import { getArticleCoordinate, getFeaturedArticleFilters, sortByFeatured, EMPTY_FEATURED_LIST, type FeaturedList } from '@/lib/featured';
+import { useFeaturedContent } from '@/hooks/useFeaturedContent';
+import { getLatestRevisions } from '@/lib/latestRevisions';
+import { isSensitive } from '@/lib/contentWarning';
 import { parseCalendarEventStartEnd } from '@/lib/eventTime';
 import { useQuery } from '@tanstack/react-query';
 import Navigation from '@/components/Navigation';
@@ -37,6 +41,7 @@ interface BlogPost {
   created_at: number;
   image?: string;
   pubkey: string;
+  d: string;
 }
 
 function AuthorInfo({ pubkey }: { pubkey: string }) {
@@ -242,16 +247,19 @@ function EventsSection({ events }: { events: Event[] }) {
   );
 }
 
-function BlogSection({ posts }: { posts: BlogPost[] }) {
+function BlogSection({ posts, featuredList }: { posts: BlogPost[]; featuredList: FeaturedList }) {
   const { config } = useAppContext();
   const showBlog = config.siteConfig?.showBlog !== false;
   const maxPosts = config.siteConfig?.maxBlogPosts || 3;
 
   if (!showBlog) return null;
 
-  const publishedPosts = posts
-    .filter(post => post.published)
-    .slice(0, maxPosts);
+  // Pinned posts lead in curation order; the remaining slots go to the most recent
+  const publishedPosts = sortByFeatured(
+    posts.filter(post => post.published),
+    featuredList,
+  ).slice(0, maxPosts);
+  const featuredCoordinates = new Set(featuredList.items);
 
   return (
     <section className="py-16 bg-muted/50">
@@ -268,6 +276,9 @@ function BlogSection({ posts }: { posts: BlogPost[] }) {
             {publishedPosts.map((post) => (
               <Card key={post.id} className="hover:shadow-lg transition-shadow">
                 <CardHeader>
+                  {featuredCoordinates.has(getArticleCoordinate(post.pubkey, post.d)) && (
+                    <Badge variant="secondary" className="w-fit">Featured</Badge>
+                  )}
                   <CardTitle className="text-lg line-clamp-2">{post.title}</CardTitle>
                   <p className="text-sm text-muted-foreground">
                     {new Date(post.created_at * 1000).toLocaleDateString()}
@@ -359,14 +370,19 @@ const Index = () => {
     enabled: !!nostr,
   });
 
+  const { data: featuredList = EMPTY_FEATURED_LIST, isLoading: featuredLoading } = useFeaturedContent();
+
   // Fetch blog posts
   const { data: posts = [], isLoading: postsLoading } = useQuery({
-    queryKey: ['blog-posts', config.siteConfig?.defaultRelay, config.siteConfig?.adminRoles],
+    queryKey: ['blog-posts', config.siteConfig?.defaultRelay, config.siteConfig?.adminRoles, featuredList.updatedAt],
     queryFn: async () => {
       const signal = AbortSignal.timeout(5000);
-      const postList = await nostr!.query([
-        { kinds: [30023], limit: 50 }
+      const events = await nostr!.query([
+        { kinds: [30023], limit: 50 },
+        ...getFeaturedArticleFilters(featuredList),
       ], { signal });
+      // One entry per article, or every stored revision of a pinned post would be featured
+      const postList = getLatestRevisions(events);
       
       const adminRoles = config.siteConfig?.adminRoles || {};
       const masterPubkey = getMasterPubkey();
@@ -379,7 +395,8 @@ const Index = () => {
           
           // Double check: don't show Kind 30023 if it's explicitly marked as NOT published
           const isPublished = event.tags.find(([name]) => name === 'published')?.[1] !== 'false';
-          return isPublished;
+          // The front page never shows content-warned posts
+          return isPublished && !isSensitive(event);
         })
         .map(event => ({
         id: event.id,
@@ -388,9 +405,10 @@ const Index = () => {
         published: event.tags.find(([name]) => name === 'published')?.[1] === 'true' || !event.tags.find(([name]) => name === 'published'),
         created_at: event.created_at,
         pubkey: event.pubkey,
+        d: event.tags.find(([name]) => name === 'd')?.[1] || '',
       }));
     },
-    enabled: !!nostr,
+    enabled: !!nostr && !featuredLoading,
   });
 
   const siteTitle = config.siteConfig?.title || 'Community Meetup Site';
@@ -420,7 +438,7 @@ const Index = () => {
       <Navigation />
       <HeroSection />
       <EventsSection events={events} />
-      <BlogSection posts={posts} />
+      <BlogSection posts={posts} featuredList={featuredList} />
     </div>
   );
 };
@@ -1,12 +1,24 @@
 import { nip19 } from 'nostr-tools';
-import { useParams } from 'react-router-dom';
+import { Navigate, useParams } from 'react-router-dom';
 import { useEffect, useMemo } from 'react';
+import { useQuery } from '@tanstack/react-query';
 import { useAppContext } from '@/hooks/useAppContext';
+import { useDefaultRelay } from '@/hooks/useDefaultRelay';
+import { getMasterPubkey } from '@/lib/relay';
 import NotFound from './NotFound';
 
-export function NIP19Page() {
-  const { nip19: identifier } = useParams<{ nip19: string }>();
-  const { config } = useAppContext();
+/** Bech32 identifiers handled at the site root, e.g. `/naddr1...`. */
+const NIP19_PATH_PATTERN = /^(npub1|nprofile1|note1|nevent1|naddr1)[023456789acdefghjklmnpqrstuvwxyz]+$/;
+
+export function isNip19Path(path: string): boolean {
+  return NIP19_PATH_PATTERN.test(path);
+}
+
+export function NIP19Page({ identifier: identifierProp }: { identifier?: string } = {}) {
+  const { nip19: identifierParam } = useParams<{ nip19: string }>();
+  const identifier = identifierProp ?? identifierParam;
+  const { config, siteConfigSynced } = useAppContext();
+  const { nostr } = useDefaultRelay();
 
   const decoded = useMemo(() => {
     try {
@@ -17,12 +29,39 @@ export function NIP19Page() {
     }
   }, [identifier]);
 
+  const articleAddress = decoded?.type === 'naddr' && decoded.data.kind === 30023 ? decoded.data : null;
+
+  // Team articles open on the site itself; everything else goes to the gateway
+  const { data: article, isLoading: isResolving } = useQuery({
+    queryKey: ['naddr-article', articleAddress?.pubkey, articleAddress?.identifier],
+    queryFn: async () => {
+      const { pubkey, identifier: d } = articleAddress!;
+      const events = await nostr!.query([
+        { kinds: [30023], authors: [pubkey], '#d': [d], limit: 1 }
+      ], { signal: AbortSignal.timeout(5000) });
+      const event = events.sort((a, b) => b.created_at - a.created_at)[0];
+      if (!event || event.tags.find(([name]) => name === 'published')?.[1] === 'false') return null;
+      return { id: event.id, pubkey: event.pubkey.toLowerCase().trim() };
+    },
+    enabled: !!nostr && !!articleAddress,
+  });
+
+  // Before the site config sync only the master pubkey is known, so primary
+  // admins' articles would otherwise be sent off-site
+  const isDeciding = !!articleAddress && (isResolving || !siteConfigSynced);
+  const adminRoles = config.siteConfig?.adminRoles || {};
+  const localPostId = article && (article.pubkey === getMasterPubkey() || adminRoles[article.pubkey] === 'primary')
+    ? article.id
+    : undefined;
+
+  const shouldRedirect = !!decoded && !isDeciding && !localPostId;
+
   useEffect(() => {
-    if (!identifier || !decoded) return;
+    if (!identifier || !shouldRedirect) return;
     const gateway = config.siteConfig?.nip19Gateway || 'https://nostr.at';
     const cleanGateway = gateway.endsWith('/') ? gateway.slice(0, -1) : gateway;
     window.location.href = `${cleanGateway}/${identifier}`;
-  }, [decoded, identifier, config.siteConfig?.nip19Gateway]);
+  }, [shouldRedirect, identifier, config.siteConfig?.nip19Gateway]);
 
   if (!identifier) {
     return <NotFound />;
@@ -32,10 +71,22 @@ export function NIP19Page() {
     return <NotFound />;
   }
 
+  if (localPostId) {
+    return <Navigate to={`/blog/${localPostId}`} replace />;
+  }
+
   return (
     <div className="flex flex-col items-center justify-center min-h-screen space-y-4">
       <div className="w-12 h-12 border-4 border-primary border-t-transparent rounded-full animate-spin" />
-      <p className="text-muted-foreground text-sm">Redirecting to Nostr gateway...</p>
+      <p className="text-muted-foreground text-sm">{isDeciding ? 'Loading...' : 'Redirecting to Nostr gateway...'}</p>
     </div>
   );
-} 
\ No newline at end of file
+} 
+/**
+ * `/r/<naddr>` reader pages are static files from the build. Articles published
+ * since the last build have none, so the app opens the article instead.
+ */
+export function ReaderViewFallback() {
+  const { naddr = '' } = useParams<{ naddr: string }>();
+  return <Navigate to={`/${naddr}`} replace />;
+}
@@ -0,0 +1,5 @@
+import AdminEmoji from "@/components/admin/AdminEmoji";
+
+export default function AdminEmojiPage() {
+  return <AdminEmoji />;
+}
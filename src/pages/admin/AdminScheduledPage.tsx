@@ -1,7 +1,8 @@
 /**
  * AdminScheduledPage - Manage scheduled posts
  *
- * View, delete, and monitor scheduled Kind 1 notes and Kind 30023 blog posts
+ * View, delete, and monitor scheduled Kind 1 notes and Kind 30023 blog posts,
+ * plus a calendar view of scheduled, draft, and published content
  */
 
 import { useState } from 'react';
@@ -34,6 +35,7 @@ import {
 } from 'lucide-react';
 import { format } from 'date-fns';
 import type { ScheduledPost } from '@/types/scheduled';
+import { EditorialCalendar } from '@/components/admin/EditorialCalendar';
 
 interface ScheduledPostCardProps {
   post: ScheduledPost;
@@ -157,7 +159,7 @@ export default function AdminScheduledPage() {
   const { data: stats } = useScheduledPostsStats(user?.pubkey);
   const { mutateAsync: deletePost } = useDeleteScheduledPost();
   const { mutateAsync: clearHistory, isPending: isClearingHistory } = useClearScheduledPostsHistory();
-  const [activeTab, setActiveTab] = useState<'pending' | 'published' | 'failed'>('pending');
+  const [activeTab, setActiveTab] = useState<'pending' | 'published' | 'failed' | 'calendar'>('pending');
   const { data: isSchedulerHealthy, isLoading: isHealthLoading } = useSchedulerHealth();
 
   const handleDelete = async (id: string) => {
@@ -339,8 +341,8 @@ export default function AdminScheduledPage() {
       )}
 
       {/* Tabs */}
-      <Tabs value={activeTab} onValueChange={(v) => setActiveTab(v as 'pending' | 'published' | 'failed')}>
-        <TabsList className="grid w-fit grid-cols-3">
+      <Tabs value={activeTab} onValueChange={(v) => setActiveTab(v as 'pending' | 'published' | 'failed' | 'calendar')}>
+        <TabsList className="grid w-fit grid-cols-4">
           <TabsTrigger value="pending">
             Pending
             {stats && stats.pending > 0 && (
@@ -365,6 +367,10 @@ export default function AdminScheduledPage() {
               </Badge>
             )}
           </TabsTrigger>
+          <TabsTrigger value="calendar">
+            <Calendar className="h-4 w-4 mr-1" />
+            Calendar
+          </TabsTrigger>
         </TabsList>
 
         <TabsContent value="pending" className="mt-4 space-y-4">
@@ -463,6 +469,10 @@ export default function AdminScheduledPage() {
             </Card>
           )}
         </TabsContent>
+
+        <TabsContent value="calendar" className="mt-4">
+          <EditorialCalendar userPubkey={user.pubkey} scheduledPosts={scheduledPosts || []} />
+        </TabsContent>
       </Tabs>
     </div>
   );